@@ -159,6 +159,21 @@ func (d ModuleCommunicator) GetAllDividendAccounts(ctx sdk.Context) []types.Divi
 	return d.App.TopupKeeper.GetAllDividendAccounts(ctx)
 }
 
+// SlashInterim adds slash of validator for infraction into slashing buffer, skipped if slashing is disabled or validator is jailed
+func (d ModuleCommunicator) SlashInterim(ctx sdk.Context, valID types.ValidatorID, slashFraction sdk.Dec, infraction string) uint64 {
+	if !d.App.SlashingKeeper.GetParams(ctx).EnableSlashing {
+		return 0
+	}
+
+	validator, ok := d.App.StakingKeeper.GetValidatorFromValID(ctx, valID)
+	valSlashingInfo, found := d.App.SlashingKeeper.GetBufferValSlashingInfo(ctx, valID)
+	if !ok || validator.Jailed || (found && valSlashingInfo.IsJailed) {
+		return 0
+	}
+
+	return d.App.SlashingKeeper.SlashInterim(ctx, valID, slashFraction, infraction)
+}
+
 // GetValidatorFromValID get validator from validator id
 func (d ModuleCommunicator) GetValidatorFromValID(ctx sdk.Context, valID types.ValidatorID) (validator types.Validator, ok bool) {
 	return d.App.StakingKeeper.GetValidatorFromValID(ctx, valID)
@@ -556,6 +571,12 @@ func (app *HeimdallApp) BeginBlocker(ctx sdk.Context, req abci.RequestBeginBlock
 		ctx,
 		types.BytesToHeimdallAddress(req.Header.GetProposerAddress()),
 	)
+
+	// run migrations of in-place upgrade on running chains
+	if height, ok := upgradeHeights[ctx.ChainID()]; ok && ctx.BlockHeight() == height {
+		app.upgrade(ctx)
+	}
+
 	return app.mm.BeginBlock(ctx, req)
 }
 
@@ -76,6 +76,9 @@ func (app *HeimdallApp) BeginSideBlocker(ctx sdk.Context, req abci.RequestBeginS
 
 			usedValidator := make(map[int]bool)
 
+			// sigs of validators who voted `yes`
+			yesSigs := make([]abci.SideTxSig, 0)
+
 			// signed power
 			signedPower := make(map[abci.SideTxResultType]int64)
 			signedPower[abci.SideTxResultType_Yes] = 0
@@ -89,6 +92,10 @@ func (app *HeimdallApp) BeginSideBlocker(ctx sdk.Context, req abci.RequestBeginS
 					if _, ok := usedValidator[i]; !ok {
 						signedPower[sigObj.Result] = signedPower[sigObj.Result] + validators[i].Power
 						usedValidator[i] = true
+
+						if sigObj.Result == abci.SideTxResultType_Yes {
+							yesSigs = append(yesSigs, sigObj)
+						}
 					}
 				}
 			}
@@ -100,8 +107,8 @@ func (app *HeimdallApp) BeginSideBlocker(ctx sdk.Context, req abci.RequestBeginS
 				// approved
 				logger.Debug("[sidechannel] Approved side-tx", "txHash", hex.EncodeToString(tx.Hash()))
 
-				// execute tx with `yes`
-				result = app.runTx(ctx, tx, abci.SideTxResultType_Yes)
+				// execute tx with `yes`, post handlers can persist approving sigs
+				result = app.runTx(types.WithSideTxSigs(ctx, yesSigs), tx, abci.SideTxResultType_Yes)
 			} else if signedPower[abci.SideTxResultType_No] >= (totalPower*2/3 + 1) {
 				// rejected
 				logger.Debug("[sidechannel] Rejected side-tx", "txHash", hex.EncodeToString(tx.Hash()))
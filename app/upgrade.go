@@ -0,0 +1,22 @@
+package app
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// upgradeHeights are heights of in-place upgrade to this release by heimdall chain id.
+// Migrations change consensus state, so heights are hard-coded per chain and never read from node config.
+var upgradeHeights = map[string]int64{}
+
+// upgrade runs migrations of in-place upgrade at upgrade height of the chain
+func (app *HeimdallApp) upgrade(ctx sdk.Context) {
+	// set params added after genesis
+	app.ChainKeeper.MigrateParams(ctx)
+	app.CheckpointKeeper.MigrateParams(ctx)
+	app.BorKeeper.MigrateParams(ctx)
+
+	// index checkpoints stored before checkpoint indexes existed
+	app.CheckpointKeeper.MigrateCheckpointIndexes(ctx)
+
+	app.Logger().Info("Upgrade migrations done", "height", ctx.BlockHeight())
+}
@@ -0,0 +1,51 @@
+package app
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	chainmanagerTypes "github.com/maticnetwork/heimdall/chainmanager/types"
+	"github.com/maticnetwork/heimdall/checkpoint"
+	checkpointTypes "github.com/maticnetwork/heimdall/checkpoint/types"
+	paramsTypes "github.com/maticnetwork/heimdall/params/types"
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+func TestUpgradeAtChainHeight(t *testing.T) {
+	app := Setup(false)
+	header := abci.Header{ChainID: "heimdall-upgrade-test", Height: 10}
+	ctx := app.BaseApp.NewContext(false, header)
+
+	// drop child chains param, as on chains started before it existed
+	store := prefix.NewStore(ctx.KVStore(app.GetKey(paramsTypes.StoreKey)), []byte(chainmanagerTypes.ModuleName+"/"))
+	store.Delete(chainmanagerTypes.KeyChildChains)
+
+	// checkpoint stored without indexes, as on chains started before indexes existed
+	rootHash := hmTypes.HexToHeimdallHash("123")
+	checkpointBlock := hmTypes.CreateBlock(0, 255, rootHash, hmTypes.HexToHeimdallAddress("123"), "1234", 1)
+	require.NoError(t, app.CheckpointKeeper.AddCheckpoint(ctx, 1, checkpointBlock))
+	checkpointStore := ctx.KVStore(app.GetKey(checkpointTypes.StoreKey))
+	checkpointStore.Delete(checkpoint.GetCheckpointByEndBlockKey(255))
+	checkpointStore.Delete(checkpoint.GetCheckpointByRootKey(rootHash))
+	checkpointStore.Delete(checkpoint.CheckpointIndexVersionKey)
+
+	upgradeHeights[header.ChainID] = 11
+	defer delete(upgradeHeights, header.ChainID)
+
+	// nothing is migrated away from upgrade height
+	app.BeginBlocker(ctx, abci.RequestBeginBlock{Header: header})
+	require.False(t, store.Has(chainmanagerTypes.KeyChildChains))
+	_, _, err := app.CheckpointKeeper.GetCheckpointByBorBlock(ctx, 100)
+	require.Error(t, err)
+
+	header.Height = 11
+	ctx = ctx.WithBlockHeader(header)
+	app.BeginBlocker(ctx, abci.RequestBeginBlock{Header: header})
+	require.True(t, store.Has(chainmanagerTypes.KeyChildChains))
+	number, _, err := app.CheckpointKeeper.GetCheckpointByBorBlock(ctx, 100)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), number)
+}
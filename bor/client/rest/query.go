@@ -118,7 +118,7 @@ func spanHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 		}
 
 		// get query params
-		queryParams, err := cliCtx.Codec.MarshalJSON(types.NewQuerySpanParams(spanID))
+		queryParams, err := cliCtx.Codec.MarshalJSON(types.NewQuerySpanParams(spanID, r.URL.Query().Get("bor_chain_id")))
 		if err != nil {
 			return
 		}
@@ -147,8 +147,15 @@ func latestSpanHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 			return
 		}
 
+		// get query params
+		queryParams, err := cliCtx.Codec.MarshalJSON(types.NewQueryBorChainIDParams(r.URL.Query().Get("bor_chain_id")))
+		if err != nil {
+			hmRest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
 		// fetch latest span
-		res, height, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryLatestSpan), nil)
+		res, height, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryLatestSpan), queryParams)
 		if err != nil {
 			hmRest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
 			return
@@ -255,7 +262,13 @@ func prepareNextSpanHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 		// Fetching SelectedProducers
 		//
 
-		nextProducerBytes, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryNextProducers), nil)
+		nextProducersParams, err := cliCtx.Codec.MarshalJSON(types.NewQueryBorChainIDParams(chainID))
+		if err != nil {
+			hmRest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		nextProducerBytes, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryNextProducers), nextProducersParams)
 		if err != nil {
 			hmRest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
 			return
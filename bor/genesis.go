@@ -24,6 +24,22 @@ func InitGenesis(ctx sdk.Context, keeper Keeper, data types.GenesisState) {
 		// update last span
 		keeper.UpdateLastSpan(ctx, data.Spans[len(data.Spans)-1].ID)
 	}
+
+	// add spans of secondary child chains, registered by chainmanager genesis
+	for _, childChain := range data.ChildChains {
+		if len(childChain.Spans) == 0 {
+			continue
+		}
+
+		hmTypes.SortSpanByID(childChain.Spans)
+		for _, span := range childChain.Spans {
+			if err := keeper.AddNewRawSpan(ctx, *span); err != nil {
+				keeper.Logger(ctx).Error("Error AddNewRawSpan", "borChainID", childChain.BorChainID, "error", err)
+			}
+		}
+
+		keeper.UpdateLastSpanForChain(ctx, childChain.BorChainID, childChain.Spans[len(childChain.Spans)-1].ID)
+	}
 }
 
 // ExportGenesis returns a GenesisState for a given context and keeper.
@@ -32,9 +48,21 @@ func ExportGenesis(ctx sdk.Context, keeper Keeper) types.GenesisState {
 
 	allSpans := keeper.GetAllSpans(ctx)
 	hmTypes.SortSpanByID(allSpans)
-	return types.NewGenesisState(
+	genesisState := types.NewGenesisState(
 		params,
 		// TODO think better way to export all spans
 		allSpans,
 	)
+
+	// export spans of secondary child chains
+	for _, childChain := range keeper.chainKeeper.GetChildChains(ctx) {
+		childSpans := keeper.GetAllSpansForChain(ctx, childChain.BorChainID)
+		hmTypes.SortSpanByID(childSpans)
+		genesisState.ChildChains = append(genesisState.ChildChains, types.ChildChainGenesisState{
+			BorChainID: childChain.BorChainID,
+			Spans:      childSpans,
+		})
+	}
+
+	return genesisState
 }
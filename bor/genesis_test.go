@@ -7,6 +7,7 @@ import (
 	"github.com/maticnetwork/heimdall/app"
 	"github.com/maticnetwork/heimdall/bor"
 	"github.com/maticnetwork/heimdall/bor/types"
+	chainmanagerTypes "github.com/maticnetwork/heimdall/chainmanager/types"
 	chSim "github.com/maticnetwork/heimdall/checkpoint/simulation"
 	hmTypes "github.com/maticnetwork/heimdall/types"
 
@@ -41,7 +42,7 @@ func (suite *GenesisTestSuite) TestInitExportGenesis() {
 	valSet := chSim.LoadValidatorSet(4, t, app.StakingKeeper, ctx, false, 10)
 	app.BorKeeper.SetParams(ctx, params)
 	app.CheckpointKeeper.UpdateACKCountWithValue(ctx, 1)
-	producers, _ := app.BorKeeper.SelectNextProducers(ctx, hmTypes.ZeroHeimdallHash.EthHash())
+	producers, _ := app.BorKeeper.SelectNextProducers(ctx, hmTypes.ZeroHeimdallHash.EthHash(), 1)
 	for i := 0; i < spancount; i++ {
 		start = end + 1
 		end = end + 10
@@ -64,3 +65,61 @@ func (suite *GenesisTestSuite) TestInitExportGenesis() {
 	require.NotNil(t, actualParams)
 	require.LessOrEqual(t, spancount, len(actualParams.Spans))
 }
+
+func (suite *GenesisTestSuite) TestInitExportChildChainGenesis() {
+	t, app, ctx := suite.T(), suite.app, suite.ctx
+
+	// register secondary child chain (chainmanager genesis runs first)
+	chainParams := chainmanagerTypes.DefaultParams()
+	chainParams.ChildChains = []chainmanagerTypes.ChildChainParams{
+		{
+			BorChainID:           "80002",
+			RootChainAddress:     hmTypes.HexToHeimdallAddress("0x0000000000000000000000000000000000002000"),
+			StateReceiverAddress: chainmanagerTypes.DefaultStateReceiverAddress,
+			ValidatorSetAddress:  chainmanagerTypes.DefaultValidatorSetAddress,
+		},
+	}
+	app.ChainKeeper.SetParams(ctx, chainParams)
+
+	valSet := chSim.LoadValidatorSet(4, t, app.StakingKeeper, ctx, false, 10)
+	primarySpan := hmTypes.NewSpan(1, 1, 10, valSet, nil, chainParams.ChainParams.BorChainID)
+	childSpans := []*hmTypes.Span{}
+	for i := uint64(1); i <= 3; i++ {
+		span := hmTypes.NewSpan(i, i*100, i*100+99, valSet, nil, "80002")
+		childSpans = append(childSpans, &span)
+	}
+
+	genesisState := types.NewGenesisState(types.DefaultParams(), []*hmTypes.Span{&primarySpan})
+	genesisState.ChildChains = []types.ChildChainGenesisState{
+		{
+			BorChainID: "80002",
+			Spans:      childSpans,
+		},
+	}
+	require.NoError(t, types.ValidateGenesis(genesisState))
+
+	bor.InitGenesis(ctx, app.BorKeeper, genesisState)
+
+	// child chain spans are kept apart from primary chain spans
+	lastSpan, err := app.BorKeeper.GetLastSpan(ctx)
+	require.NoError(t, err)
+	require.Equal(t, primarySpan.ID, lastSpan.ID)
+	lastSpan, err = app.BorKeeper.GetLastSpanForChain(ctx, "80002")
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), lastSpan.ID)
+
+	exported := bor.ExportGenesis(ctx, app.BorKeeper)
+	require.Len(t, exported.Spans, 1)
+	require.Len(t, exported.ChildChains, 1)
+	require.Equal(t, "80002", exported.ChildChains[0].BorChainID)
+	require.Len(t, exported.ChildChains[0].Spans, len(childSpans))
+	for i, span := range exported.ChildChains[0].Spans {
+		require.Equal(t, childSpans[i].ID, span.ID)
+		require.Equal(t, childSpans[i].EndBlock, span.EndBlock)
+		require.Equal(t, "80002", span.ChainID)
+	}
+
+	// span of other chain is rejected in child chain state
+	genesisState.ChildChains[0].Spans[0] = &primarySpan
+	require.Error(t, types.ValidateGenesis(genesisState))
+}
@@ -34,23 +34,23 @@ func HandleMsgProposeSpan(ctx sdk.Context, msg types.MsgProposeSpan, k Keeper) s
 
 	// chainManager params
 	params := k.chainKeeper.GetParams(ctx)
-	chainParams := params.ChainParams
 
-	// check chain id
-	if chainParams.BorChainID != msg.ChainID {
+	// check chain id is one of registered child chains
+	if _, ok := params.GetChildChain(msg.ChainID); !ok {
 		k.Logger(ctx).Error("Invalid Bor chain id", "msgChainID", msg.ChainID)
 		return common.ErrInvalidBorChainID(k.Codespace()).Result()
 	}
 
 	// check if last span is up or if greater diff than threshold is found between validator set
-	lastSpan, err := k.GetLastSpan(ctx)
+	lastSpan, err := k.GetLastSpanForChain(ctx, msg.ChainID)
 	if err != nil {
-		k.Logger(ctx).Error("Unable to fetch last span", "Error", err)
-		return common.ErrSpanNotFound(k.Codespace()).Result()
-	}
-
-	// Validate span continuity
-	if lastSpan.ID+1 != msg.ID || msg.StartBlock != lastSpan.EndBlock+1 || msg.EndBlock < msg.StartBlock {
+		// secondary child chain registered after genesis starts with span 0 from block 0
+		if !params.IsSecondaryChain(msg.ChainID) || msg.ID != 0 || msg.StartBlock != 0 {
+			k.Logger(ctx).Error("Unable to fetch last span", "Error", err)
+			return common.ErrSpanNotFound(k.Codespace()).Result()
+		}
+	} else if lastSpan.ID+1 != msg.ID || msg.StartBlock != lastSpan.EndBlock+1 || msg.EndBlock < msg.StartBlock {
+		// Validate span continuity
 		k.Logger(ctx).Error("Blocks not in countinuity",
 			"lastSpanId", lastSpan.ID,
 			"spanId", msg.ID,
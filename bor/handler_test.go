@@ -9,6 +9,7 @@ import (
 	"github.com/maticnetwork/heimdall/app"
 	"github.com/maticnetwork/heimdall/bor"
 	borTypes "github.com/maticnetwork/heimdall/bor/types"
+	chainmanagerTypes "github.com/maticnetwork/heimdall/chainmanager/types"
 	"github.com/maticnetwork/heimdall/common"
 	hmTypes "github.com/maticnetwork/heimdall/types"
 	"github.com/stretchr/testify/suite"
@@ -153,3 +154,42 @@ func (suite handlerSuite) TestHandleMsgProposeSpan() {
 
 	}
 }
+
+func (suite *handlerSuite) TestHandleMsgProposeSpanChildChain() {
+	app, ctx := suite.app, suite.ctx
+
+	// register secondary child chain
+	chainParams := app.ChainKeeper.GetParams(ctx)
+	chainParams.ChildChains = []chainmanagerTypes.ChildChainParams{
+		{
+			BorChainID:           "80002",
+			RootChainAddress:     hmTypes.HexToHeimdallAddress("0x0000000000000000000000000000000000002000"),
+			StateReceiverAddress: chainmanagerTypes.DefaultStateReceiverAddress,
+			ValidatorSetAddress:  chainmanagerTypes.DefaultValidatorSetAddress,
+		},
+	}
+	app.ChainKeeper.SetParams(ctx, chainParams)
+	app.BorKeeper.SetParams(ctx, borTypes.Params{SpanDuration: 2})
+	app.BorKeeper.AddNewSpan(ctx, hmTypes.Span{ID: 5, StartBlock: 0, EndBlock: 1, ChainID: "15001"})
+
+	// first span of secondary chain starts from block 0
+	out := bor.HandleMsgProposeSpan(ctx, borTypes.MsgProposeSpan{ID: 0, StartBlock: 0, EndBlock: 1, ChainID: "80002"}, app.BorKeeper)
+	suite.True(out.IsOK(), "first span of child chain should be valid")
+
+	out = bor.HandleMsgProposeSpan(ctx, borTypes.MsgProposeSpan{ID: 1, StartBlock: 2, EndBlock: 3, ChainID: "80002"}, app.BorKeeper)
+	suite.Equal(common.ErrSpanNotFound("1").Result(), out)
+
+	// spans are kept per chain
+	app.BorKeeper.AddNewSpan(ctx, hmTypes.Span{ID: 0, StartBlock: 0, EndBlock: 1, ChainID: "80002"})
+
+	out = bor.HandleMsgProposeSpan(ctx, borTypes.MsgProposeSpan{ID: 1, StartBlock: 2, EndBlock: 3, ChainID: "80002"}, app.BorKeeper)
+	suite.True(out.IsOK(), "next span of child chain should be valid")
+
+	lastSpan, err := app.BorKeeper.GetLastSpan(ctx)
+	suite.NoError(err)
+	suite.Equal(uint64(5), lastSpan.ID)
+
+	lastSpan, err = app.BorKeeper.GetLastSpanForChain(ctx, "80002")
+	suite.NoError(err)
+	suite.Equal(uint64(0), lastSpan.ID)
+}
@@ -6,6 +6,7 @@ import (
 	"strconv"
 
 	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/tendermint/tendermint/libs/log"
 
@@ -28,6 +29,7 @@ var (
 	SpanPrefixKey         = []byte{0x36} // prefix key to store span
 	SpanCacheKey          = []byte{0x37} // key to store Cache for span
 	LastProcessedEthBlock = []byte{0x38} // key to store last processed eth block for seed
+	ChildChainKey         = []byte{0x39} // prefix key to store spans of secondary child chains
 )
 
 // Keeper stores all related data
@@ -79,14 +81,32 @@ func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", types.ModuleName)
 }
 
+// chainStore returns span store of child chain.
+// Primary chain (and unregistered chain id) uses module store, secondary child chains use prefixed store.
+func (k *Keeper) chainStore(ctx sdk.Context, borChainID string) sdk.KVStore {
+	store := ctx.KVStore(k.storeKey)
+	if borChainID == "" || !k.chainKeeper.IsSecondaryChain(ctx, borChainID) {
+		return store
+	}
+
+	return prefix.NewStore(store, GetChildChainKey(borChainID))
+}
+
+// GetChildChainKey returns store prefix of secondary child chain (length prefixed chain id)
+func GetChildChainKey(borChainID string) []byte {
+	key := append([]byte{}, ChildChainKey...)
+	key = append(key, byte(len(borChainID)))
+	return append(key, []byte(borChainID)...)
+}
+
 // GetSpanKey appends prefix to start block
 func GetSpanKey(id uint64) []byte {
 	return append(SpanPrefixKey, []byte(strconv.FormatUint(id, 10))...)
 }
 
-// AddNewSpan adds new span for bor to store
+// AddNewSpan adds new span for bor to store of its child chain
 func (k *Keeper) AddNewSpan(ctx sdk.Context, span hmTypes.Span) error {
-	store := ctx.KVStore(k.storeKey)
+	store := k.chainStore(ctx, span.ChainID)
 	out, err := k.cdc.MarshalBinaryBare(span)
 	if err != nil {
 		k.Logger(ctx).Error("Error marshalling span", "error", err)
@@ -103,13 +123,13 @@ func (k *Keeper) AddNewSpan(ctx sdk.Context, span hmTypes.Span) error {
 	store.Set(spanKey, out)
 
 	// update last span
-	k.UpdateLastSpan(ctx, span.ID)
+	k.UpdateLastSpanForChain(ctx, span.ChainID, span.ID)
 	return nil
 }
 
-// AddNewRawSpan adds new span for bor to store
+// AddNewRawSpan adds new span for bor to store of its child chain
 func (k *Keeper) AddNewRawSpan(ctx sdk.Context, span hmTypes.Span) error {
-	store := ctx.KVStore(k.storeKey)
+	store := k.chainStore(ctx, span.ChainID)
 	out, err := k.cdc.MarshalBinaryBare(span)
 	if err != nil {
 		k.Logger(ctx).Error("Error marshalling span", "error", err)
@@ -121,7 +141,12 @@ func (k *Keeper) AddNewRawSpan(ctx sdk.Context, span hmTypes.Span) error {
 
 // GetSpan fetches span indexed by id from store
 func (k *Keeper) GetSpan(ctx sdk.Context, id uint64) (*hmTypes.Span, error) {
-	store := ctx.KVStore(k.storeKey)
+	return k.GetSpanForChain(ctx, "", id)
+}
+
+// GetSpanForChain fetches span of child chain indexed by id from store
+func (k *Keeper) GetSpanForChain(ctx sdk.Context, borChainID string, id uint64) (*hmTypes.Span, error) {
+	store := k.chainStore(ctx, borChainID)
 	spanKey := GetSpanKey(id)
 
 	// If we are starting from 0 there will be no spanKey present
@@ -138,15 +163,33 @@ func (k *Keeper) GetSpan(ctx sdk.Context, id uint64) (*hmTypes.Span, error) {
 }
 
 func (k *Keeper) HasSpan(ctx sdk.Context, id uint64) bool {
-	store := ctx.KVStore(k.storeKey)
+	return k.HasSpanForChain(ctx, "", id)
+}
+
+// HasSpanForChain checks if span of child chain exists
+func (k *Keeper) HasSpanForChain(ctx sdk.Context, borChainID string, id uint64) bool {
+	store := k.chainStore(ctx, borChainID)
 	spanKey := GetSpanKey(id)
 	return store.Has(spanKey)
 }
 
+// HasSpansForChain checks if any span of child chain exists
+func (k *Keeper) HasSpansForChain(ctx sdk.Context, borChainID string) bool {
+	iterator := sdk.KVStorePrefixIterator(k.chainStore(ctx, borChainID), SpanPrefixKey)
+	defer iterator.Close()
+
+	return iterator.Valid()
+}
+
 // GetAllSpans fetches all indexed by id from store
 func (k *Keeper) GetAllSpans(ctx sdk.Context) (spans []*hmTypes.Span) {
+	return k.GetAllSpansForChain(ctx, "")
+}
+
+// GetAllSpansForChain returns all spans of child chain
+func (k *Keeper) GetAllSpansForChain(ctx sdk.Context, borChainID string) (spans []*hmTypes.Span) {
 	// iterate through spans and create span update array
-	k.IterateSpansAndApplyFn(ctx, func(span hmTypes.Span) error {
+	k.IterateSpansForChainAndApplyFn(ctx, borChainID, func(span hmTypes.Span) error {
 		// append to list of validatorUpdates
 		spans = append(spans, &span)
 		return nil
@@ -183,7 +226,12 @@ func (k *Keeper) GetSpanList(ctx sdk.Context, page uint64, limit uint64) ([]hmTy
 
 // GetLastSpan fetches last span using lastStartBlock
 func (k *Keeper) GetLastSpan(ctx sdk.Context) (*hmTypes.Span, error) {
-	store := ctx.KVStore(k.storeKey)
+	return k.GetLastSpanForChain(ctx, "")
+}
+
+// GetLastSpanForChain fetches last span of child chain
+func (k *Keeper) GetLastSpanForChain(ctx sdk.Context, borChainID string) (*hmTypes.Span, error) {
+	store := k.chainStore(ctx, borChainID)
 
 	var lastSpanID uint64
 	if store.Has(LastSpanIDKey) {
@@ -195,14 +243,14 @@ func (k *Keeper) GetLastSpan(ctx sdk.Context) (*hmTypes.Span, error) {
 		}
 	}
 
-	return k.GetSpan(ctx, lastSpanID)
+	return k.GetSpanForChain(ctx, borChainID, lastSpanID)
 }
 
 // FreezeSet freezes validator set for next span
 func (k *Keeper) FreezeSet(ctx sdk.Context, id uint64, startBlock uint64, endBlock uint64, borChainID string, seed common.Hash) error {
 
 	// select next producers
-	newProducers, err := k.SelectNextProducers(ctx, seed)
+	newProducers, err := k.SelectNextProducers(ctx, seed, id)
 	if err != nil {
 		return err
 	}
@@ -220,25 +268,30 @@ func (k *Keeper) FreezeSet(ctx sdk.Context, id uint64, startBlock uint64, endBlo
 		borChainID,
 	)
 
+	// record strategy so that producers of span can be re-verified
+	newSpan.ProducerSelection = k.getProducerSelection(ctx)
+
 	return k.AddNewSpan(ctx, newSpan)
 }
 
-// SelectNextProducers selects producers for next span
-func (k *Keeper) SelectNextProducers(ctx sdk.Context, seed common.Hash) (vals []hmTypes.Validator, err error) {
+// SelectNextProducers selects producers for next span with active producer selection strategy
+func (k *Keeper) SelectNextProducers(ctx sdk.Context, seed common.Hash, spanID uint64) (vals []hmTypes.Validator, err error) {
 	// spanEligibleVals are current validators who are not getting deactivated in between next span
 	spanEligibleVals := k.sk.GetSpanEligibleValidators(ctx)
 	producerCount := k.GetParams(ctx).ProducerCount
-	if err != nil {
-		return vals, err
-	}
 
 	// if producers to be selected is more than current validators no need to select/shuffle
 	if len(spanEligibleVals) <= int(producerCount) {
 		return spanEligibleVals, nil
 	}
 
+	selectProducers, err := GetProducerSelector(k.getProducerSelection(ctx))
+	if err != nil {
+		return vals, err
+	}
+
 	// select next producers using seed as blockheader hash
-	newProducersIds, err := SelectNextProducers(seed, spanEligibleVals, producerCount)
+	newProducersIds, err := selectProducers(seed, spanID, spanEligibleVals, producerCount)
 	if err != nil {
 		return vals, err
 	}
@@ -261,7 +314,12 @@ func (k *Keeper) SelectNextProducers(ctx sdk.Context, seed common.Hash) (vals []
 
 // UpdateLastSpan updates the last span start block
 func (k *Keeper) UpdateLastSpan(ctx sdk.Context, id uint64) {
-	store := ctx.KVStore(k.storeKey)
+	k.UpdateLastSpanForChain(ctx, "", id)
+}
+
+// UpdateLastSpanForChain updates the last span id of child chain
+func (k *Keeper) UpdateLastSpanForChain(ctx sdk.Context, borChainID string, id uint64) {
+	store := k.chainStore(ctx, borChainID)
 	store.Set(LastSpanIDKey, []byte(strconv.FormatUint(id, 10)))
 }
 
@@ -319,17 +377,45 @@ func (k *Keeper) SetParams(ctx sdk.Context, params types.Params) {
 
 // GetParams gets the bor module's parameters.
 func (k *Keeper) GetParams(ctx sdk.Context) (params types.Params) {
-	k.paramSpace.GetParamSet(ctx, &params)
+	// params added after genesis keep defaults until set by governance or upgrade
+	params = types.DefaultParams()
+	for _, pair := range params.ParamSetPairs() {
+		k.paramSpace.GetIfExists(ctx, pair.Key, pair.Value)
+	}
 	return
 }
 
+// MigrateParams sets params which are missing in store (added after genesis) to their defaults.
+// It runs once at upgrade height of the chain, InitGenesis sets all params on new chains.
+func (k *Keeper) MigrateParams(ctx sdk.Context) {
+	params := types.DefaultParams()
+	for _, pair := range params.ParamSetPairs() {
+		if !k.paramSpace.Has(ctx, pair.Key) {
+			k.paramSpace.Set(ctx, pair.Key, pair.Value)
+		}
+	}
+}
+
+// getProducerSelection returns active producer selection strategy, stake-weighted if not set
+func (k *Keeper) getProducerSelection(ctx sdk.Context) string {
+	if selection := k.GetParams(ctx).ProducerSelection; selection != "" {
+		return selection
+	}
+	return types.ProducerSelectionStakeWeighted
+}
+
 //
 // Utils
 //
 
 // IterateSpansAndApplyFn interate spans and apply the given function.
 func (k *Keeper) IterateSpansAndApplyFn(ctx sdk.Context, f func(span hmTypes.Span) error) {
-	store := ctx.KVStore(k.storeKey)
+	k.IterateSpansForChainAndApplyFn(ctx, "", f)
+}
+
+// IterateSpansForChainAndApplyFn iterate spans of child chain and apply the given function.
+func (k *Keeper) IterateSpansForChainAndApplyFn(ctx sdk.Context, borChainID string, f func(span hmTypes.Span) error) {
+	store := k.chainStore(ctx, borChainID)
 
 	// get span iterator
 	iterator := sdk.KVStorePrefixIterator(store, SpanPrefixKey)
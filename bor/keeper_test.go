@@ -120,6 +120,32 @@ func (suite *keeperTest) TestSelectNextProducers() {
 	}
 }
 
+func (suite *keeperTest) TestFreezeRecordsProducerSelection() {
+	simulation.LoadValidatorSet(4, suite.T(), suite.app.StakingKeeper, suite.ctx, true, 0)
+
+	params := bortypes.DefaultParams()
+	params.ProducerCount = 2
+	params.ProducerSelection = bortypes.ProducerSelectionRotation
+	suite.app.BorKeeper.SetParams(suite.ctx, params)
+
+	err := suite.app.BorKeeper.FreezeSet(suite.ctx, 1, 0, 100, "15001", common.HexToHash("testSeed"))
+	suite.NoError(err)
+
+	span, err := suite.app.BorKeeper.GetSpan(suite.ctx, 1)
+	suite.NoError(err)
+	suite.Equal(bortypes.ProducerSelectionRotation, span.ProducerSelection)
+
+	// producers can be re-verified with recorded strategy
+	selectProducers, err := bor.GetProducerSelector(span.ProducerSelection)
+	suite.NoError(err)
+	producerIds, err := selectProducers(common.HexToHash("testSeed"), span.ID, suite.app.StakingKeeper.GetSpanEligibleValidators(suite.ctx), params.ProducerCount)
+	suite.NoError(err)
+	suite.Equal(len(producerIds), len(span.SelectedProducers))
+	for _, producer := range span.SelectedProducers {
+		suite.Contains(producerIds, producer.ID.Uint64())
+	}
+}
+
 func (suite *keeperTest) TestBorKeeperSelectNextProducers() {
 
 	tc := []struct {
@@ -157,7 +183,7 @@ func (suite *keeperTest) TestBorKeeperSelectNextProducers() {
 		cVals := suite.app.StakingKeeper.GetValidatorSet(suite.ctx)
 		suite.app.BorKeeper.SetParams(suite.ctx, bortypes.Params{SprintDuration: 1, SpanDuration: 1, ProducerCount: c.producerCount})
 		cMsg := fmt.Sprintf("i: %v, msg: %v", i, c.msg)
-		out, err := suite.app.BorKeeper.SelectNextProducers(suite.ctx, c.seed)
+		out, err := suite.app.BorKeeper.SelectNextProducers(suite.ctx, c.seed, 1)
 
 		// pVals is used to check if validators are being modified during execution
 		pVals := suite.app.StakingKeeper.GetValidatorSet(suite.ctx)
@@ -85,7 +85,7 @@ func handleQuerySpan(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]b
 		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
 	}
 
-	span, err := keeper.GetSpan(ctx, params.RecordID)
+	span, err := keeper.GetSpanForChain(ctx, params.BorChainID, params.RecordID)
 	if err != nil {
 		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not get span", err.Error()))
 	}
@@ -122,10 +122,17 @@ func handleQuerySpanList(ctx sdk.Context, req abci.RequestQuery, keeper Keeper)
 }
 
 func handleQueryLatestSpan(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	// chain id is optional, primary chain without data
+	var params types.QueryBorChainIDParams
+	if len(req.Data) > 0 {
+		if err := keeper.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+			return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+		}
+	}
+
 	var defaultSpan hmTypes.Span
-	spans := keeper.GetAllSpans(ctx)
 	// if this is the first span return empty span
-	if len(spans) == 0 {
+	if !keeper.HasSpansForChain(ctx, params.BorChainID) {
 		// json record
 		bz, err := json.Marshal(defaultSpan)
 		if err != nil {
@@ -135,7 +142,7 @@ func handleQueryLatestSpan(ctx sdk.Context, req abci.RequestQuery, keeper Keeper
 	}
 
 	// explcitly fetch the last span
-	span, err := keeper.GetLastSpan(ctx)
+	span, err := keeper.GetLastSpanForChain(ctx, params.BorChainID)
 	if err != nil {
 		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not get span", err.Error()))
 	}
@@ -154,12 +161,26 @@ func handleQueryLatestSpan(ctx sdk.Context, req abci.RequestQuery, keeper Keeper
 }
 
 func handleQueryNextProducers(ctx sdk.Context, req abci.RequestQuery, keeper Keeper, contractCaller helper.IContractCaller) ([]byte, sdk.Error) {
+	// chain id is optional, primary chain without data
+	var params types.QueryBorChainIDParams
+	if len(req.Data) > 0 {
+		if err := keeper.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+			return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+		}
+	}
+
 	nextSpanSeed, err := keeper.GetNextSpanSeed(ctx, contractCaller)
 	if err != nil {
 		return nil, sdk.ErrInternal((sdk.AppendMsgToErr("cannot fetch next span seed from keeper", err.Error())))
 	}
 
-	nextProducers, err := keeper.SelectNextProducers(ctx, nextSpanSeed)
+	// next span id, rotation strategy depends on it
+	var nextSpanID uint64
+	if lastSpan, err := keeper.GetLastSpanForChain(ctx, params.BorChainID); err == nil && lastSpan != nil {
+		nextSpanID = lastSpan.ID + 1
+	}
+
+	nextProducers, err := keeper.SelectNextProducers(ctx, nextSpanSeed, nextSpanID)
 	if err != nil {
 		return nil, sdk.ErrInternal((sdk.AppendMsgToErr("cannot fetch next producers from keeper", err.Error())))
 	}
@@ -70,7 +70,7 @@ func (suite *querierHandlerSuite) TestNewQueirer() {
 		},
 		{
 
-			expResp: []byte(`{"sprint_duration":64,"span_duration":6400,"producer_count":4}`),
+			expResp: []byte(`{"sprint_duration":64,"span_duration":6400,"producer_count":4,"producer_selection":"stake-weighted"}`),
 			path:    []string{types.QueryParams},
 			msg:     "happy flow for empty path query",
 		},
@@ -1,12 +1,40 @@
 package bor
 
 import (
+	"fmt"
+	"sort"
+
 	"github.com/maticnetwork/bor/common"
 	"github.com/maticnetwork/heimdall/bor/types"
 	"github.com/maticnetwork/heimdall/helper"
 	hmTypes "github.com/maticnetwork/heimdall/types"
 )
 
+// ProducerSelector selects producers for span from span eligible validators, selected ids hold one entry per producer slot
+type ProducerSelector func(seed common.Hash, spanID uint64, spanEligibleVals []hmTypes.Validator, producerCount uint64) ([]uint64, error)
+
+// producerSelectors is registry of producer selection strategies, active strategy is set by governance
+var producerSelectors = map[string]ProducerSelector{
+	types.ProducerSelectionStakeWeighted: func(seed common.Hash, _ uint64, spanEligibleVals []hmTypes.Validator, producerCount uint64) ([]uint64, error) {
+		return SelectNextProducers(seed, spanEligibleVals, producerCount)
+	},
+	types.ProducerSelectionUniform:  selectUniformProducers,
+	types.ProducerSelectionRotation: selectRotationProducers,
+}
+
+// GetProducerSelector returns producer selection strategy by name, empty name is stake-weighted selection
+func GetProducerSelector(name string) (ProducerSelector, error) {
+	if name == "" {
+		name = types.ProducerSelectionStakeWeighted
+	}
+
+	selector, ok := producerSelectors[name]
+	if !ok {
+		return nil, fmt.Errorf("unknown producer selection: %s", name)
+	}
+	return selector, nil
+}
+
 // SelectNextProducers selects producers for next span by converting power to tickets
 func SelectNextProducers(blkHash common.Hash, spanEligibleVals []hmTypes.Validator, producerCount uint64) (selectedIDs []uint64, err error) {
 	if len(spanEligibleVals) <= int(producerCount) {
@@ -28,6 +56,48 @@ func SelectNextProducers(blkHash common.Hash, spanEligibleVals []hmTypes.Validat
 	return selectedIDs[:producerCount], nil
 }
 
+// selectUniformProducers selects producers by shuffling eligible validators, every validator has one slot irrespective of power
+func selectUniformProducers(blkHash common.Hash, _ uint64, spanEligibleVals []hmTypes.Validator, producerCount uint64) (selectedIDs []uint64, err error) {
+	validatorIndices := make([]uint64, 0, len(spanEligibleVals))
+	for _, val := range spanEligibleVals {
+		validatorIndices = append(validatorIndices, uint64(val.ID))
+	}
+
+	if len(validatorIndices) <= int(producerCount) {
+		return validatorIndices, nil
+	}
+
+	// extract seed from hash
+	seed := helper.ToBytes32(blkHash.Bytes()[:32])
+	selectedIDs, err = ShuffleList(validatorIndices, seed)
+	if err != nil {
+		return
+	}
+	return selectedIDs[:producerCount], nil
+}
+
+// selectRotationProducers selects consecutive eligible validators ordered by id, starting point moves by producer count
+// with every span so that consecutive spans cover all eligible validators
+func selectRotationProducers(_ common.Hash, spanID uint64, spanEligibleVals []hmTypes.Validator, producerCount uint64) (selectedIDs []uint64, err error) {
+	validatorIndices := make([]uint64, 0, len(spanEligibleVals))
+	for _, val := range spanEligibleVals {
+		validatorIndices = append(validatorIndices, uint64(val.ID))
+	}
+
+	if len(validatorIndices) <= int(producerCount) {
+		return validatorIndices, nil
+	}
+
+	sort.Slice(validatorIndices, func(i, j int) bool { return validatorIndices[i] < validatorIndices[j] })
+
+	total := uint64(len(validatorIndices))
+	start := (spanID % total) * (producerCount % total) % total
+	for i := uint64(0); i < producerCount; i++ {
+		selectedIDs = append(selectedIDs, validatorIndices[(start+i)%total])
+	}
+	return selectedIDs, nil
+}
+
 // converts validator power to slots
 // TODO remove 2nd loop
 func convertToSlots(vals []hmTypes.Validator) (validatorIndices []uint64) {
@@ -6,6 +6,7 @@ import (
 	"testing"
 
 	"github.com/maticnetwork/bor/common"
+	"github.com/maticnetwork/heimdall/bor/types"
 	hmTypes "github.com/maticnetwork/heimdall/types"
 	"github.com/stretchr/testify/require"
 )
@@ -43,6 +44,70 @@ func TestSelectNextProducers(t *testing.T) {
 	}
 }
 
+func TestProducerSelectors(t *testing.T) {
+	var validators []hmTypes.Validator
+	json.Unmarshal([]byte(testValidators), &validators)
+	seed := common.HexToHash("0x8f5bab218b6bb34476f51ca588e9f4553a3a7ce5e13a66c660a5283e97e9a85a")
+
+	for _, name := range types.ProducerSelections {
+		selector, err := GetProducerSelector(name)
+		require.NoError(t, err, name)
+
+		producerIds, err := selector(seed, 1, validators, 3)
+		require.NoError(t, err, name)
+		require.Equal(t, 3, len(producerIds), name)
+	}
+
+	_, err := GetProducerSelector("unknown")
+	require.Error(t, err)
+
+	// empty strategy is stake-weighted
+	selector, err := GetProducerSelector("")
+	require.NoError(t, err)
+	producerIds, err := selector(seed, 1, validators, 4)
+	require.NoError(t, err)
+	expectedIds, err := SelectNextProducers(seed, validators, 4)
+	require.NoError(t, err)
+	require.Equal(t, expectedIds, producerIds)
+}
+
+func TestSelectUniformProducers(t *testing.T) {
+	var validators []hmTypes.Validator
+	json.Unmarshal([]byte(testValidators), &validators)
+	seed := common.HexToHash("0xe09cc356df20c7a2dd38cb85b680a16ec29bd8b3e1ecc1b20f2e5603d5e7ee85")
+
+	producerIds, err := selectUniformProducers(seed, 0, validators, 4)
+	require.NoError(t, err)
+
+	producers, slots := getSelectedValidtorsFromIDs(validators, producerIds)
+	require.Equal(t, int64(4), slots)
+	require.Equal(t, 4, len(producers), "every selected validator should have one slot")
+}
+
+func TestSelectRotationProducers(t *testing.T) {
+	var validators []hmTypes.Validator
+	json.Unmarshal([]byte(testValidators), &validators)
+
+	producerIds, err := selectRotationProducers(common.Hash{}, 0, validators, 2)
+	require.NoError(t, err)
+	require.Equal(t, []uint64{1, 2}, producerIds)
+
+	producerIds, err = selectRotationProducers(common.Hash{}, 2, validators, 2)
+	require.NoError(t, err)
+	require.Equal(t, []uint64{5, 1}, producerIds)
+
+	// consecutive spans cover all eligible validators
+	produced := make(map[uint64]bool)
+	for spanID := uint64(10); spanID < 13; spanID++ {
+		producerIds, err := selectRotationProducers(common.Hash{}, spanID, validators, 2)
+		require.NoError(t, err)
+		for _, id := range producerIds {
+			produced[id] = true
+		}
+	}
+	require.Equal(t, len(validators), len(produced))
+}
+
 func getSelectedValidtorsFromIDs(validators []hmTypes.Validator, producerIds []uint64) ([]hmTypes.Validator, int64) {
 	var vals []hmTypes.Validator
 	IDToPower := make(map[uint64]uint64)
@@ -67,6 +67,17 @@ func SideHandleMsgSpan(ctx sdk.Context, k Keeper, msg types.MsgProposeSpan, cont
 		return hmCommon.ErrorSideTx(k.Codespace(), common.CodeInvalidMsg)
 	}
 
+	// read bor data from child chain of span
+	chainParams := k.chainKeeper.GetParams(ctx)
+	if chainParams.IsSecondaryChain(msg.ChainID) {
+		childChainCaller, err := contractCaller.GetChildChainCaller(msg.ChainID)
+		if err != nil {
+			k.Logger(ctx).Error("Unable to get child chain caller", "error", err, "chainID", msg.ChainID)
+			return hmCommon.ErrorSideTx(k.Codespace(), common.CodeInvalidMsg)
+		}
+		contractCaller = childChainCaller
+	}
+
 	// fetch current child block
 	childBlock, err := contractCaller.GetMaticChainBlock(nil)
 	if err != nil {
@@ -74,8 +85,15 @@ func SideHandleMsgSpan(ctx sdk.Context, k Keeper, msg types.MsgProposeSpan, cont
 		return hmCommon.ErrorSideTx(k.Codespace(), common.CodeInvalidMsg)
 	}
 
-	lastSpan, err := k.GetLastSpan(ctx)
+	lastSpan, err := k.GetLastSpanForChain(ctx, msg.ChainID)
 	if err != nil {
+		// first span of secondary child chain has no previous span to be in-turn with
+		if chainParams.IsSecondaryChain(msg.ChainID) && msg.ID == 0 {
+			k.Logger(ctx).Debug("✅ Succesfully validated External call for first span msg", "chainID", msg.ChainID)
+			result.Result = abci.SideTxResultType_Yes
+			return
+		}
+
 		k.Logger(ctx).Error("Error fetching last span", "error", err)
 		return hmCommon.ErrorSideTx(k.Codespace(), common.CodeInvalidMsg)
 	}
@@ -106,7 +124,7 @@ func PostHandleMsgEventSpan(ctx sdk.Context, k Keeper, msg types.MsgProposeSpan,
 	}
 
 	// check for replay
-	if found := k.HasSpan(ctx, msg.ID); found {
+	if found := k.HasSpanForChain(ctx, msg.ChainID, msg.ID); found {
 		k.Logger(ctx).Debug("Skipping new span as it's already processed")
 		return hmCommon.ErrOldTx(k.Codespace()).Result()
 	}
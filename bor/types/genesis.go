@@ -2,6 +2,7 @@ package types
 
 import (
 	"encoding/json"
+	"fmt"
 
 	chainmanagerTypes "github.com/maticnetwork/heimdall/chainmanager/types"
 	"github.com/maticnetwork/heimdall/gov/types"
@@ -12,6 +13,14 @@ import (
 type GenesisState struct {
 	Params Params          `json:"params" yaml:"params"`
 	Spans  []*hmTypes.Span `json:"spans" yaml:"spans"` // list of spans
+
+	ChildChains []ChildChainGenesisState `json:"child_chains" yaml:"child_chains"` // spans of secondary child chains
+}
+
+// ChildChainGenesisState is the bor state of secondary child chain
+type ChildChainGenesisState struct {
+	BorChainID string          `json:"bor_chain_id" yaml:"bor_chain_id"`
+	Spans      []*hmTypes.Span `json:"spans" yaml:"spans"`
 }
 
 // NewGenesisState creates a new genesis state.
@@ -34,6 +43,14 @@ func ValidateGenesis(data GenesisState) error {
 		return err
 	}
 
+	for _, childChain := range data.ChildChains {
+		for _, span := range childChain.Spans {
+			if span.ChainID != childChain.BorChainID {
+				return fmt.Errorf("Span of chain %s in state of child chain %s", span.ChainID, childChain.BorChainID)
+			}
+		}
+	}
+
 	return nil
 }
 
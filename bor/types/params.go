@@ -19,13 +19,30 @@ const (
 	DefaultSpanDuration      uint64 = 100 * DefaultSprintDuration
 	DefaultFirstSpanDuration uint64 = 256
 	DefaultProducerCount     uint64 = 4
+	DefaultProducerSelection string = ProducerSelectionStakeWeighted
 )
 
+// Producer selection strategies
+const (
+	ProducerSelectionStakeWeighted = "stake-weighted" // shuffle of slots proportional to voting power
+	ProducerSelectionUniform       = "uniform"        // shuffle of eligible validators with one slot each
+	ProducerSelectionRotation      = "rotation"       // eligible validators ordered by id, window moves with span id
+)
+
+// ProducerSelections are producer selection strategies which can be set by governance
+var ProducerSelections = []string{
+	ProducerSelectionStakeWeighted,
+	ProducerSelectionUniform,
+	ProducerSelectionRotation,
+}
+
 // Parameter keys
 var (
 	KeySprintDuration = []byte("SprintDuration")
 	KeySpanDuration   = []byte("SpanDuration")
 	KeyProducerCount  = []byte("ProducerCount")
+
+	KeyProducerSelection = []byte("ProducerSelection")
 )
 
 var _ subspace.ParamSet = &Params{}
@@ -35,6 +52,8 @@ type Params struct {
 	SprintDuration uint64 `json:"sprint_duration" yaml:"sprint_duration"` // sprint duration
 	SpanDuration   uint64 `json:"span_duration" yaml:"span_duration"`     // span duration ie number of blocks for which val set is frozen on heimdall
 	ProducerCount  uint64 `json:"producer_count" yaml:"producer_count"`   // producer count per span
+
+	ProducerSelection string `json:"producer_selection" yaml:"producer_selection"` // producer selection strategy, empty is stake-weighted
 }
 
 // NewParams creates a new Params object
@@ -54,6 +73,7 @@ func (p *Params) ParamSetPairs() subspace.ParamSetPairs {
 		{KeySprintDuration, &p.SprintDuration},
 		{KeySpanDuration, &p.SpanDuration},
 		{KeyProducerCount, &p.ProducerCount},
+		{KeyProducerSelection, &p.ProducerSelection},
 	}
 }
 
@@ -71,6 +91,7 @@ func (p Params) String() string {
 	sb.WriteString(fmt.Sprintf("SprintDuration: %d\n", p.SprintDuration))
 	sb.WriteString(fmt.Sprintf("SpanDuration: %d\n", p.SpanDuration))
 	sb.WriteString(fmt.Sprintf("ProducerCount: %d\n", p.ProducerCount))
+	sb.WriteString(fmt.Sprintf("ProducerSelection: %s\n", p.ProducerSelection))
 	return sb.String()
 }
 
@@ -88,6 +109,10 @@ func (p Params) Validate() error {
 		return err
 	}
 
+	if err := validateProducerSelection(p.ProducerSelection); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -106,6 +131,8 @@ func DefaultParams() Params {
 		SprintDuration: DefaultSprintDuration,
 		SpanDuration:   DefaultSpanDuration,
 		ProducerCount:  DefaultProducerCount,
+
+		ProducerSelection: DefaultProducerSelection,
 	}
 }
 
@@ -147,3 +174,23 @@ func validateProducerCount(i interface{}) error {
 
 	return nil
 }
+
+func validateProducerSelection(i interface{}) error {
+	v, ok := i.(string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	// empty strategy is kept for params set before strategies were added
+	if v == "" {
+		return nil
+	}
+
+	for _, selection := range ProducerSelections {
+		if v == selection {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("invalid producer selection: %s", v)
+}
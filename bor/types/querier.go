@@ -18,10 +18,21 @@ const (
 
 // QuerySpanParams defines the params for querying accounts.
 type QuerySpanParams struct {
-	RecordID uint64
+	RecordID   uint64
+	BorChainID string
 }
 
 // NewQuerySpanParams creates a new instance of QuerySpanParams.
-func NewQuerySpanParams(recordID uint64) QuerySpanParams {
-	return QuerySpanParams{RecordID: recordID}
+func NewQuerySpanParams(recordID uint64, borChainID string) QuerySpanParams {
+	return QuerySpanParams{RecordID: recordID, BorChainID: borChainID}
+}
+
+// QueryBorChainIDParams defines the params for querying with bor chain id
+type QueryBorChainIDParams struct {
+	BorChainID string
+}
+
+// NewQueryBorChainIDParams creates a new instance of QueryBorChainIDParams.
+func NewQueryBorChainIDParams(borChainID string) QueryBorChainIDParams {
+	return QueryBorChainIDParams{BorChainID: borChainID}
 }
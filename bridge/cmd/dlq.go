@@ -0,0 +1,105 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/maticnetwork/heimdall/bridge/setu/queue"
+	"github.com/maticnetwork/heimdall/bridge/setu/util"
+	"github.com/maticnetwork/heimdall/helper"
+)
+
+// GetDeadLetterCmd returns the command to manage tasks which have no retries left
+func GetDeadLetterCmd() *cobra.Command {
+	dlqCmd := &cobra.Command{
+		Use:   "dlq",
+		Short: "Manage dead-letter queue of tasks which have no retries left",
+		Long: `Manage dead-letter queue of tasks which have no retries left.
+Bridge db is locked while bridge is running, use /dlq endpoints on admin server instead.`,
+	}
+
+	dlqCmd.AddCommand(
+		&cobra.Command{
+			Use:   "list",
+			Short: "List dead letters",
+			Args:  cobra.NoArgs,
+			RunE: func(cmd *cobra.Command, args []string) error {
+				defer util.CloseBridgeDBInstance()
+
+				deadLetters, err := getDeadLetterQueue().List()
+				if err != nil {
+					return err
+				}
+
+				for _, deadLetter := range deadLetters {
+					fmt.Printf("%v\t%v\t%v\t%v\n", deadLetter.UUID, deadLetter.Name, deadLetter.FailedAt.Format("2006-01-02T15:04:05Z"), deadLetter.LastError)
+				}
+				return nil
+			},
+		},
+		&cobra.Command{
+			Use:   "show [uuid]",
+			Short: "Show dead letter with payload and attempt history",
+			Args:  cobra.ExactArgs(1),
+			RunE: func(cmd *cobra.Command, args []string) error {
+				defer util.CloseBridgeDBInstance()
+
+				deadLetter, err := getDeadLetterQueue().Get(args[0])
+				if err != nil {
+					return err
+				}
+
+				output, err := json.MarshalIndent(deadLetter, "", "  ")
+				if err != nil {
+					return err
+				}
+
+				fmt.Println(string(output))
+				return nil
+			},
+		},
+		&cobra.Command{
+			Use:   "retry [uuid]",
+			Short: "Send dead letter back to queue",
+			Args:  cobra.ExactArgs(1),
+			RunE: func(cmd *cobra.Command, args []string) error {
+				defer util.CloseBridgeDBInstance()
+
+				queueConnector, err := queue.NewQueueConnector(helper.GetConfig().QueueBackend, helper.GetConfig().AmqpURL)
+				if err != nil {
+					return fmt.Errorf("Error connecting to queue %v", err)
+				}
+
+				return queueConnector.RetryDeadLetter(args[0])
+			},
+		},
+		&cobra.Command{
+			Use:   "drop [uuid]",
+			Short: "Remove dead letter",
+			Args:  cobra.ExactArgs(1),
+			RunE: func(cmd *cobra.Command, args []string) error {
+				defer util.CloseBridgeDBInstance()
+
+				return getDeadLetterQueue().Drop(args[0])
+			},
+		},
+	)
+
+	return dlqCmd
+}
+
+func getDeadLetterQueue() *queue.DeadLetterQueue {
+	bridgeDB := util.GetBridgeDBInstance(viper.GetString(util.BridgeDBFlag))
+	if bridgeDB == nil {
+		panic("Unable to open bridge db, stop bridge before managing dead letters")
+	}
+
+	return queue.NewDeadLetterQueue(bridgeDB)
+}
+
+func init() {
+	rootCmd.AddCommand(GetDeadLetterCmd())
+}
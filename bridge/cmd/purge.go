@@ -2,9 +2,9 @@ package cmd
 
 import (
 	"github.com/maticnetwork/heimdall/bridge/setu/queue"
+	"github.com/maticnetwork/heimdall/bridge/setu/util"
 	"github.com/maticnetwork/heimdall/helper"
 	"github.com/spf13/cobra"
-	"github.com/streadway/amqp"
 )
 
 // purgeCmd represents the reset of queue
@@ -19,23 +19,19 @@ var purgeCmd = &cobra.Command{
 
 func purgeQueue() {
 	var logger = helper.Logger.With("module", "bridge/cmd/")
-	dialer := helper.GetConfig().AmqpURL
 
-	// amqp dialer
-	conn, err := amqp.Dial(dialer)
+	// queue connector for configured backend
+	queueConnector, err := queue.NewQueueConnector(helper.GetConfig().QueueBackend, helper.GetConfig().AmqpURL)
 	if err != nil {
 		panic(err)
 	}
 
-	// initialize exchange
-	channel, err := conn.Channel()
-	if err != nil {
-		panic(err)
+	if err := queueConnector.PurgeQueue(); err != nil {
+		logger.Error("purgeQueue | PurgeQueue", "Error", err)
 	}
 
-	if _, err := channel.QueuePurge(queue.QueueName, false); err != nil {
-		logger.Error("purgeQueue | QueuePurge", "Error", err)
-	}
+	// close db instance
+	util.CloseBridgeDBInstance()
 }
 
 func init() {
@@ -0,0 +1,117 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	httpClient "github.com/tendermint/tendermint/rpc/client"
+
+	"github.com/maticnetwork/heimdall/app"
+	"github.com/maticnetwork/heimdall/bridge/setu/listener"
+	"github.com/maticnetwork/heimdall/bridge/setu/queue"
+	"github.com/maticnetwork/heimdall/bridge/setu/util"
+	"github.com/maticnetwork/heimdall/helper"
+)
+
+const (
+	chainFlag  = "chain"
+	fromFlag   = "from"
+	toFlag     = "to"
+	eventFlag  = "event"
+	dryRunFlag = "dry-run"
+)
+
+// GetReplayCmd returns the replay command to replay events of a block range
+func GetReplayCmd() *cobra.Command {
+	var logger = helper.Logger.With("module", "bridge/cmd/")
+	replayCmd := &cobra.Command{
+		Use:   "replay",
+		Short: "Replay rootchain or heimdall events of a block range",
+		Long: `Replay rootchain or heimdall events between --from and --to (inclusive) and send tasks to queue.
+Stored listener cursors are not moved.
+
+Example:
+heimdall-bridge replay --chain root --from 100 --to 200 --event StateSynced,Staked --dry-run`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			chain, err := cmd.Flags().GetString(chainFlag)
+			if err != nil {
+				return err
+			}
+			fromBlock, err := cmd.Flags().GetUint64(fromFlag)
+			if err != nil {
+				return err
+			}
+			toBlock, err := cmd.Flags().GetUint64(toFlag)
+			if err != nil {
+				return err
+			}
+			if toBlock < fromBlock {
+				return fmt.Errorf("Invalid block range %v-%v", fromBlock, toBlock)
+			}
+
+			events, err := cmd.Flags().GetStringSlice(eventFlag)
+			if err != nil {
+				return err
+			}
+			dryRun, err := cmd.Flags().GetBool(dryRunFlag)
+			if err != nil {
+				return err
+			}
+
+			options := listener.ReplayOptions{
+				Events: events,
+				DryRun: dryRun,
+			}
+
+			// create codec
+			cdc := app.MakeCodec()
+
+			// queue connector (not needed for dry run)
+			var queueConnector *queue.QueueConnector
+			if !options.DryRun {
+				queueConnector, err = queue.NewQueueConnector(helper.GetConfig().QueueBackend, helper.GetConfig().AmqpURL)
+				if err != nil {
+					return fmt.Errorf("Error connecting to queue %v", err)
+				}
+			}
+
+			// http client
+			_httpClient := httpClient.NewHTTP(helper.GetConfig().TendermintRPCUrl, "/websocket")
+			if err := _httpClient.Start(); err != nil {
+				return fmt.Errorf("Error connecting to server %v", err)
+			}
+			defer func() {
+				if err := _httpClient.Stop(); err != nil {
+					logger.Error("GetReplayCmd | _httpClient.Stop", "Error", err)
+				}
+
+				// close db instance
+				util.CloseBridgeDBInstance()
+			}()
+
+			switch chain {
+			case "root":
+				return listener.ReplayRootChain(cdc, queueConnector, _httpClient, fromBlock, toBlock, options)
+			case "heimdall":
+				return listener.ReplayHeimdall(cdc, queueConnector, _httpClient, fromBlock, toBlock, options)
+			default:
+				return fmt.Errorf("Invalid chain %v, use root or heimdall", chain)
+			}
+		}}
+
+	replayCmd.Flags().String(chainFlag, "", "chain to replay events from (root or heimdall)")
+	replayCmd.Flags().Uint64(fromFlag, 0, "first block to replay")
+	replayCmd.Flags().Uint64(toFlag, 0, "last block to replay")
+	replayCmd.Flags().StringSlice(eventFlag, []string{}, "comma separated events to replay (all events if empty)")
+	replayCmd.Flags().Bool(dryRunFlag, false, "log tasks instead of sending them to queue")
+
+	if err := replayCmd.MarkFlagRequired(chainFlag); err != nil {
+		logger.Error("GetReplayCmd | MarkFlagRequired | "+chainFlag, "Error", err)
+	}
+
+	return replayCmd
+}
+
+func init() {
+	rootCmd.AddCommand(GetReplayCmd())
+}
@@ -0,0 +1,48 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	cliContext "github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/maticnetwork/heimdall/app"
+	"github.com/maticnetwork/heimdall/bridge/setu/broadcaster"
+	"github.com/maticnetwork/heimdall/bridge/setu/util"
+)
+
+// GetShadowReportCmd returns the command to diff shadow mode records against heimdall
+func GetShadowReportCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "shadow-report",
+		Short: "Compare txs recorded in shadow mode with heimdall state",
+		Long: `Compare txs recorded by "start --shadow" with heimdall state.
+Bridge db is locked while bridge is running, use GET /shadow/report on admin server instead.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := cliContext.NewCLIContext().WithCodec(app.MakeCodec())
+			cliCtx.BroadcastMode = client.BroadcastSync
+			cliCtx.TrustNode = true
+
+			defer util.CloseBridgeDBInstance()
+
+			report, err := broadcaster.GetShadowReport(cliCtx, util.GetBridgeDBInstance(viper.GetString(util.BridgeDBFlag)))
+			if err != nil {
+				return err
+			}
+
+			output, err := json.MarshalIndent(report, "", "  ")
+			if err != nil {
+				return err
+			}
+
+			fmt.Println(string(output))
+			return nil
+		}}
+}
+
+func init() {
+	rootCmd.AddCommand(GetShadowReportCmd())
+}
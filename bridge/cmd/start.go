@@ -1,6 +1,7 @@
 package cmd
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"os/signal"
@@ -13,16 +14,21 @@ import (
 
 	"github.com/spf13/viper"
 	"github.com/tendermint/tendermint/libs/common"
+	"github.com/tendermint/tendermint/libs/log"
 	httpClient "github.com/tendermint/tendermint/rpc/client"
 
 	cliContext "github.com/cosmos/cosmos-sdk/client/context"
 	"github.com/maticnetwork/heimdall/app"
+	"github.com/maticnetwork/heimdall/bridge/setu/admin"
 	"github.com/maticnetwork/heimdall/bridge/setu/broadcaster"
 	"github.com/maticnetwork/heimdall/bridge/setu/listener"
+	"github.com/maticnetwork/heimdall/bridge/setu/metrics"
+	"github.com/maticnetwork/heimdall/bridge/setu/notify"
 	"github.com/maticnetwork/heimdall/bridge/setu/processor"
 	"github.com/maticnetwork/heimdall/bridge/setu/queue"
 	"github.com/maticnetwork/heimdall/bridge/setu/util"
 	"github.com/maticnetwork/heimdall/helper"
+	hmTypes "github.com/maticnetwork/heimdall/types"
 )
 
 const (
@@ -41,51 +47,104 @@ func GetStartCmd() *cobra.Command {
 			// create codec
 			cdc := app.MakeCodec()
 			// queue connector & http client
-			_queueConnector := queue.NewQueueConnector(helper.GetConfig().AmqpURL)
+			_queueConnector, err := queue.NewQueueConnector(helper.GetConfig().QueueBackend, helper.GetConfig().AmqpURL)
+			if err != nil {
+				panic(fmt.Sprintf("Error connecting to queue %v", err))
+			}
 			_queueConnector.StartWorker()
 
 			_txBroadcaster := broadcaster.NewTxBroadcaster(cdc)
+			if _txBroadcaster.IsShadow() {
+				logger.Info("Running in shadow mode, txs are recorded instead of being sent")
+			}
+			// follow pending rootchain txs
+			rootchainTxCtx, cancelRootchainTxMonitor := context.WithCancel(context.Background())
+			go _txBroadcaster.StartRootchainTxMonitor(rootchainTxCtx)
+
+			// webhook notifications
+			notifyTargets, err := notify.ParseTargets(helper.GetConfig().NotifyWebhooks)
+			if err != nil {
+				panic(fmt.Sprintf("Invalid notify_webhooks %v", err))
+			}
+			var notificationService *notify.NotificationService
+			if len(notifyTargets) > 0 {
+				notificationService = notify.NewNotificationService(notifyTargets, helper.GetConfig().NotifyRateLimit, hmTypes.BytesToHeimdallAddress(helper.GetAddress()).String())
+				notify.SetDefaultService(notificationService)
+			}
+
+			// check fee token and main chain balances
+			if interval := helper.GetConfig().BalanceCheckInterval; interval > 0 {
+				go _txBroadcaster.StartBalanceMonitor(rootchainTxCtx, interval)
+			}
+
 			_httpClient := httpClient.NewHTTP(helper.GetConfig().TendermintRPCUrl, "/websocket")
 
 			// selected services to start
+			listenerService := listener.NewListenerService(cdc, _queueConnector, _httpClient)
+			processorService := processor.NewProcessorService(cdc, _queueConnector, _httpClient, _txBroadcaster)
 			services := []common.Service{}
 			services = append(services,
-				listener.NewListenerService(cdc, _queueConnector, _httpClient),
-				processor.NewProcessorService(cdc, _queueConnector, _httpClient, _txBroadcaster),
+				listenerService,
+				processorService,
 			)
 
-			// sync group
-			var wg sync.WaitGroup
+			// prometheus metrics server
+			if helper.GetConfig().BridgeMetricsListenAddr != "" {
+				services = append(services, metrics.NewMetricsService(helper.GetConfig().BridgeMetricsListenAddr))
+			}
 
-			// go routine to catch signal
-			catchSignal := make(chan os.Signal, 1)
-			signal.Notify(catchSignal, os.Interrupt, syscall.SIGTERM)
-			go func() {
-				// sig is a ^C, handle it
-				for range catchSignal {
-					// stop processes
-					logger.Info("Received stop signal - Stopping all services")
-					for _, service := range services {
-						if err := service.Stop(); err != nil {
-							logger.Error("GetStartCmd | service.Stop", "Error", err)
-						}
+			// webhook notifications
+			if notificationService != nil {
+				services = append(services, notificationService)
+			}
+
+			// admin/status server
+			if viper.GetString(admin.ListenAddrFlag) != "" {
+				services = append(services, admin.NewAdminService(listenerService, processorService, _queueConnector, _txBroadcaster))
+			}
+
+			// stops listeners first so no new tasks are sent, drains in-flight tasks and stops the rest
+			quit, stopped := make(chan struct{}), make(chan struct{})
+			var shutdownOnce sync.Once
+			shutdown := func() {
+				shutdownOnce.Do(func() {
+					logger.Info("Stopping all services")
+					close(quit)
+
+					stopService(logger, listenerService)
+					_queueConnector.StopWorker()
+					for _, service := range services[1:] {
+						stopService(logger, service)
 					}
 
+					// stop rootchain tx and balance monitors
+					cancelRootchainTxMonitor()
+
 					// stop http client
-					if err := _httpClient.Stop(); err != nil {
-						logger.Error("GetStartCmd | _httpClient.Stop", "Error", err)
+					if _httpClient.IsRunning() {
+						if err := _httpClient.Stop(); err != nil {
+							logger.Error("GetStartCmd | _httpClient.Stop", "Error", err)
+						}
 					}
 
 					// stop db instance
 					util.CloseBridgeDBInstance()
+					close(stopped)
+				})
+			}
 
-					// exit
-					os.Exit(1)
-				}
+			// go routine to catch signal
+			catchSignal := make(chan os.Signal, 1)
+			signal.Notify(catchSignal, os.Interrupt, syscall.SIGTERM)
+			go func() {
+				// sig is a ^C, handle it
+				sig := <-catchSignal
+				logger.Info("Received stop signal, draining in-flight tasks", "signal", sig)
+				shutdown()
 			}()
 
 			// Start http client
-			err := _httpClient.Start()
+			err = _httpClient.Start()
 			if err != nil {
 				panic(fmt.Sprintf("Error connecting to server %v", err))
 			}
@@ -96,30 +155,40 @@ func GetStartCmd() *cobra.Command {
 			cliCtx.TrustNode = true
 
 			// start bridge services only when node fully synced
-			for {
-				if !util.IsCatchingUp(cliCtx) {
-					logger.Info("Node upto date, starting bridge services")
-					break
-				} else {
-					logger.Info("Waiting for heimdall to be synced")
+			for util.IsCatchingUp(cliCtx) {
+				logger.Info("Waiting for heimdall to be synced")
+				select {
+				case <-time.After(waitDuration):
+				case <-quit:
+					<-stopped
+					return
 				}
-				time.Sleep(waitDuration)
 			}
+			logger.Info("Node upto date, starting bridge services")
 
-			// strt all processes
+			// start all services, listeners and processors are restarted by their supervisors if they fail
+			var startErr error
+		startServices:
 			for _, service := range services {
-				go func(serv common.Service) {
-					defer wg.Done()
-					// TODO handle error while starting service
-					if err := serv.Start(); err != nil {
-						logger.Error("GetStartCmd | serv.Start", "Error", err)
-					}
-					<-serv.Quit()
-				}(service)
+				select {
+				case <-quit:
+					break startServices
+				default:
+				}
+
+				if startErr = service.Start(); startErr != nil {
+					logger.Error("Error starting service", "service", service, "error", startErr)
+					shutdown()
+					break
+				}
+			}
+
+			// wait for all services to stop
+			<-stopped
+			logger.Info("All services stopped")
+			if startErr != nil {
+				os.Exit(1)
 			}
-			// wait for all processes
-			wg.Add(len(services))
-			wg.Wait()
 		}}
 
 	// log level
@@ -133,13 +202,34 @@ func GetStartCmd() *cobra.Command {
 		logger.Error("GetStartCmd | BindPFlag | all", "Error", err)
 	}
 
-	startCmd.Flags().StringSlice("only", []string{}, "comma separated bridge services to start")
+	startCmd.Flags().StringSlice("only", []string{}, "comma separated processors and listeners to start, listeners needed by selected processors are started too")
 	if err := viper.BindPFlag("only", startCmd.Flags().Lookup("only")); err != nil {
 		logger.Error("GetStartCmd | BindPFlag | only", "Error", err)
 	}
+
+	startCmd.Flags().Bool(broadcaster.ShadowFlag, false, "record txs instead of sending them to heimdall, bor and rootchain")
+	if err := viper.BindPFlag(broadcaster.ShadowFlag, startCmd.Flags().Lookup(broadcaster.ShadowFlag)); err != nil {
+		logger.Error("GetStartCmd | BindPFlag | "+broadcaster.ShadowFlag, "Error", err)
+	}
+
+	startCmd.Flags().String(admin.ListenAddrFlag, admin.DefaultListenAddr, "listen address for bridge admin/status server (empty to disable)")
+	if err := viper.BindPFlag(admin.ListenAddrFlag, startCmd.Flags().Lookup(admin.ListenAddrFlag)); err != nil {
+		logger.Error("GetStartCmd | BindPFlag | "+admin.ListenAddrFlag, "Error", err)
+	}
 	return startCmd
 }
 
+// stopService stops service if it is running
+func stopService(logger log.Logger, service common.Service) {
+	if !service.IsRunning() {
+		return
+	}
+
+	if err := service.Stop(); err != nil {
+		logger.Error("GetStartCmd | service.Stop", "service", service, "Error", err)
+	}
+}
+
 func init() {
 	rootCmd.AddCommand(GetStartCmd())
 }
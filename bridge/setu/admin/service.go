@@ -0,0 +1,370 @@
+package admin
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/types/rest"
+	"github.com/gorilla/mux"
+	"github.com/spf13/viper"
+	"github.com/syndtr/goleveldb/leveldb"
+	"github.com/tendermint/tendermint/libs/common"
+
+	"github.com/maticnetwork/heimdall/bridge/setu/broadcaster"
+	"github.com/maticnetwork/heimdall/bridge/setu/listener"
+	"github.com/maticnetwork/heimdall/bridge/setu/processor"
+	"github.com/maticnetwork/heimdall/bridge/setu/queue"
+	"github.com/maticnetwork/heimdall/bridge/setu/supervisor"
+	"github.com/maticnetwork/heimdall/bridge/setu/util"
+)
+
+const (
+	adminServiceStr = "admin"
+
+	// ListenAddrFlag is the flag for admin http server listen address
+	ListenAddrFlag = "admin_listen_addr"
+
+	// DefaultListenAddr is the default admin http server listen address (local only)
+	DefaultListenAddr = "127.0.0.1:8646"
+
+	shutdownTimeout = 5 * time.Second
+)
+
+// ListenerStatus represents cursor of a listener
+type ListenerStatus struct {
+	Name      string  `json:"name"`
+	LastBlock *uint64 `json:"last_block"`
+}
+
+// BroadcasterStatus represents cached heimdall account of the broadcaster
+type BroadcasterStatus struct {
+	AccountNumber uint64 `json:"account_number"`
+	Sequence      uint64 `json:"sequence"`
+}
+
+// HealthStatus represents health of supervised listeners and processors
+type HealthStatus struct {
+	Healthy    bool                `json:"healthy"`
+	Components []supervisor.Health `json:"components"`
+}
+
+// Status represents overall bridge status
+type Status struct {
+	Listeners   []ListenerStatus            `json:"listeners"`
+	Processors  []string                    `json:"processors"`
+	Components  []supervisor.Health         `json:"components"`
+	Tasks       map[string]*queue.TaskStats `json:"tasks"`
+	Broadcaster BroadcasterStatus           `json:"broadcaster"`
+}
+
+// CursorRequest represents request to force listener cursor
+type CursorRequest struct {
+	Block uint64 `json:"block"`
+}
+
+// AdminService serves bridge status and admin actions over http
+type AdminService struct {
+	// Base service
+	common.BaseService
+
+	server *http.Server
+
+	listenerService  *listener.ListenerService
+	processorService *processor.ProcessorService
+	queueConnector   *queue.QueueConnector
+	txBroadcaster    *broadcaster.TxBroadcaster
+
+	// storage client
+	storageClient *leveldb.DB
+}
+
+// NewAdminService returns new service object for admin http server
+func NewAdminService(
+	listenerService *listener.ListenerService,
+	processorService *processor.ProcessorService,
+	queueConnector *queue.QueueConnector,
+	txBroadcaster *broadcaster.TxBroadcaster,
+) *AdminService {
+	var logger = util.Logger().With("service", adminServiceStr)
+
+	adminService := &AdminService{
+		listenerService:  listenerService,
+		processorService: processorService,
+		queueConnector:   queueConnector,
+		txBroadcaster:    txBroadcaster,
+		storageClient:    util.GetBridgeDBInstance(viper.GetString(util.BridgeDBFlag)),
+	}
+
+	adminService.BaseService = *common.NewBaseService(logger, adminServiceStr, adminService)
+	adminService.server = &http.Server{
+		Addr:    viper.GetString(ListenAddrFlag),
+		Handler: adminService.router(),
+	}
+
+	return adminService
+}
+
+// OnStart starts admin http server
+func (adminService *AdminService) OnStart() error {
+	if err := adminService.BaseService.OnStart(); err != nil {
+		adminService.Logger.Error("OnStart | OnStart", "Error", err)
+	} // Always call the overridden method.
+
+	go func() {
+		adminService.Logger.Info("Starting admin server", "address", adminService.server.Addr)
+		if err := adminService.server.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+			adminService.Logger.Error("Error while serving admin server", "error", err)
+		}
+	}()
+
+	return nil
+}
+
+// OnStop stops admin http server
+func (adminService *AdminService) OnStop() {
+	adminService.BaseService.OnStop() // Always call the overridden method.
+
+	ctx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+	defer cancel()
+
+	if err := adminService.server.Shutdown(ctx); err != nil {
+		adminService.Logger.Error("Error while stopping admin server", "error", err)
+	}
+
+	adminService.Logger.Info("admin server stopped")
+}
+
+func (adminService *AdminService) router() *mux.Router {
+	r := mux.NewRouter()
+
+	r.HandleFunc("/status", adminService.statusHandlerFn).Methods("GET")
+	r.HandleFunc("/health", adminService.healthHandlerFn).Methods("GET")
+	r.HandleFunc("/listeners", adminService.listenersHandlerFn).Methods("GET")
+	r.HandleFunc("/listeners/{name}/cursor", adminService.setCursorHandlerFn).Methods("POST")
+	r.HandleFunc("/processors", adminService.processorsHandlerFn).Methods("GET")
+	r.HandleFunc("/tasks", adminService.tasksHandlerFn).Methods("GET")
+	r.HandleFunc("/broadcaster", adminService.broadcasterHandlerFn).Methods("GET")
+	r.HandleFunc("/broadcaster/resync", adminService.resyncHandlerFn).Methods("POST")
+	r.HandleFunc("/shadow/report", adminService.shadowReportHandlerFn).Methods("GET")
+	r.HandleFunc("/dlq", adminService.deadLettersHandlerFn).Methods("GET")
+	r.HandleFunc("/dlq/{uuid}", adminService.deadLetterHandlerFn).Methods("GET")
+	r.HandleFunc("/dlq/{uuid}/retry", adminService.retryDeadLetterHandlerFn).Methods("POST")
+	r.HandleFunc("/dlq/{uuid}", adminService.dropDeadLetterHandlerFn).Methods("DELETE")
+
+	return r
+}
+
+//
+// Handlers
+//
+
+func (adminService *AdminService) statusHandlerFn(w http.ResponseWriter, r *http.Request) {
+	listeners, err := adminService.getListeners()
+	if err != nil {
+		rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	tasks, err := adminService.queueConnector.TaskStats()
+	if err != nil {
+		rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	writeJSONResponse(w, Status{
+		Listeners:   listeners,
+		Processors:  adminService.processorService.Processors(),
+		Components:  adminService.getHealth().Components,
+		Tasks:       tasks,
+		Broadcaster: adminService.getBroadcaster(),
+	})
+}
+
+func (adminService *AdminService) healthHandlerFn(w http.ResponseWriter, r *http.Request) {
+	health := adminService.getHealth()
+	if !health.Healthy {
+		writeJSONResponseWithStatus(w, http.StatusServiceUnavailable, health)
+		return
+	}
+
+	writeJSONResponse(w, health)
+}
+
+func (adminService *AdminService) listenersHandlerFn(w http.ResponseWriter, r *http.Request) {
+	listeners, err := adminService.getListeners()
+	if err != nil {
+		rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	writeJSONResponse(w, listeners)
+}
+
+func (adminService *AdminService) setCursorHandlerFn(w http.ResponseWriter, r *http.Request) {
+	name := mux.Vars(r)["name"]
+
+	var req CursorRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	if err := listener.SetListenerCursor(adminService.storageClient, name, req.Block); err != nil {
+		rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	adminService.Logger.Info("Listener cursor updated", "listener", name, "lastBlock", req.Block)
+	writeJSONResponse(w, ListenerStatus{Name: name, LastBlock: &req.Block})
+}
+
+func (adminService *AdminService) processorsHandlerFn(w http.ResponseWriter, r *http.Request) {
+	writeJSONResponse(w, adminService.processorService.Processors())
+}
+
+func (adminService *AdminService) tasksHandlerFn(w http.ResponseWriter, r *http.Request) {
+	tasks, err := adminService.queueConnector.TaskStats()
+	if err != nil {
+		rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	writeJSONResponse(w, tasks)
+}
+
+func (adminService *AdminService) broadcasterHandlerFn(w http.ResponseWriter, r *http.Request) {
+	writeJSONResponse(w, adminService.getBroadcaster())
+}
+
+func (adminService *AdminService) resyncHandlerFn(w http.ResponseWriter, r *http.Request) {
+	if err := adminService.txBroadcaster.ResyncAccountSequence(); err != nil {
+		rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	writeJSONResponse(w, adminService.getBroadcaster())
+}
+
+func (adminService *AdminService) shadowReportHandlerFn(w http.ResponseWriter, r *http.Request) {
+	report, err := adminService.txBroadcaster.ShadowReport()
+	if err != nil {
+		rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	writeJSONResponse(w, report)
+}
+
+func (adminService *AdminService) deadLettersHandlerFn(w http.ResponseWriter, r *http.Request) {
+	deadLetters, err := adminService.queueConnector.DeadLetters().List()
+	if err != nil {
+		rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	writeJSONResponse(w, deadLetters)
+}
+
+func (adminService *AdminService) deadLetterHandlerFn(w http.ResponseWriter, r *http.Request) {
+	deadLetter, err := adminService.queueConnector.DeadLetters().Get(mux.Vars(r)["uuid"])
+	if err != nil {
+		writeDeadLetterError(w, err)
+		return
+	}
+
+	writeJSONResponse(w, deadLetter)
+}
+
+func (adminService *AdminService) retryDeadLetterHandlerFn(w http.ResponseWriter, r *http.Request) {
+	uuid := mux.Vars(r)["uuid"]
+	if err := adminService.queueConnector.RetryDeadLetter(uuid); err != nil {
+		writeDeadLetterError(w, err)
+		return
+	}
+
+	adminService.Logger.Info("Dead letter retried", "uuid", uuid)
+	writeJSONResponse(w, uuid)
+}
+
+func (adminService *AdminService) dropDeadLetterHandlerFn(w http.ResponseWriter, r *http.Request) {
+	uuid := mux.Vars(r)["uuid"]
+	if err := adminService.queueConnector.DeadLetters().Drop(uuid); err != nil {
+		writeDeadLetterError(w, err)
+		return
+	}
+
+	adminService.Logger.Info("Dead letter dropped", "uuid", uuid)
+	writeJSONResponse(w, uuid)
+}
+
+//
+// utils
+//
+
+func (adminService *AdminService) getListeners() ([]ListenerStatus, error) {
+	var result []ListenerStatus
+	for _, name := range listener.CursorListeners() {
+		lastBlock, ok, err := listener.GetListenerCursor(adminService.storageClient, name)
+		if err != nil {
+			return nil, err
+		}
+
+		status := ListenerStatus{Name: name}
+		if ok {
+			status.LastBlock = &lastBlock
+		}
+		result = append(result, status)
+	}
+
+	return result, nil
+}
+
+func (adminService *AdminService) getHealth() HealthStatus {
+	components := append(adminService.listenerService.Health(), adminService.processorService.Health()...)
+
+	healthy := true
+	for _, component := range components {
+		if component.State != supervisor.StateRunning {
+			healthy = false
+		}
+	}
+
+	return HealthStatus{Healthy: healthy, Components: components}
+}
+
+func (adminService *AdminService) getBroadcaster() BroadcasterStatus {
+	accNum, seqNo := adminService.txBroadcaster.GetAccountSequence()
+	return BroadcasterStatus{
+		AccountNumber: accNum,
+		Sequence:      seqNo,
+	}
+}
+
+func writeJSONResponse(w http.ResponseWriter, result interface{}) {
+	writeJSONResponseWithStatus(w, http.StatusOK, result)
+}
+
+func writeJSONResponseWithStatus(w http.ResponseWriter, status int, result interface{}) {
+	output, err := json.MarshalIndent(result, "", "  ")
+	if err != nil {
+		rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Content-Length", strconv.Itoa(len(output)))
+	w.WriteHeader(status)
+	_, _ = w.Write(output)
+}
+
+func writeDeadLetterError(w http.ResponseWriter, err error) {
+	if err == queue.ErrDeadLetterNotFound {
+		rest.WriteErrorResponse(w, http.StatusNotFound, err.Error())
+		return
+	}
+
+	rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+}
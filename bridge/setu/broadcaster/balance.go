@@ -0,0 +1,56 @@
+package broadcaster
+
+import (
+	"context"
+	"math/big"
+	"time"
+
+	"github.com/maticnetwork/bor/common"
+
+	authTypes "github.com/maticnetwork/heimdall/auth/types"
+	"github.com/maticnetwork/heimdall/bridge/setu/notify"
+	"github.com/maticnetwork/heimdall/bridge/setu/util"
+	"github.com/maticnetwork/heimdall/helper"
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+// StartBalanceMonitor checks balances used to pay for txs at given interval
+func (tb *TxBroadcaster) StartBalanceMonitor(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	tb.logger.Info("Start monitoring balances", "interval", interval)
+	tb.checkBalances()
+	for {
+		select {
+		case <-ticker.C:
+			tb.checkBalances()
+		case <-ctx.Done():
+			tb.logger.Info("Balance monitor stopped")
+			return
+		}
+	}
+}
+
+// checkBalances publishes notifications if fee token balance on heimdall or ETH balance on main chain is low
+func (tb *TxBroadcaster) checkBalances() {
+	// fee tokens on heimdall
+	if minFeeBalance, ok := big.NewInt(0).SetString(helper.GetConfig().MinFeeBalance, 10); ok {
+		account, err := util.GetAccount(tb.cliCtx, hmTypes.BytesToHeimdallAddress(helper.GetAddress()))
+		if err != nil {
+			tb.logger.Error("Error fetching account from rest-api", "error", err)
+		} else if balance := account.GetCoins().AmountOf(authTypes.FeeToken).BigInt(); balance.Cmp(minFeeBalance) < 0 {
+			tb.logger.Error("Fee token balance is low", "balance", balance, "minBalance", minFeeBalance)
+			notify.Publish(notify.NewLowFeeBalanceEvent(balance, minFeeBalance))
+		}
+	}
+
+	// ether on main chain
+	balance, err := helper.GetMainClient().BalanceAt(context.Background(), common.BytesToAddress(helper.GetAddress()), nil)
+	if err != nil {
+		tb.logger.Error("Error fetching main chain balance", "error", err)
+	} else if balance.Cmp(helper.MinBalance) < 0 {
+		tb.logger.Error("Main chain balance is low", "balance", balance, "minBalance", helper.MinBalance)
+		notify.Publish(notify.NewLowEthBalanceEvent(balance, helper.MinBalance))
+	}
+}
@@ -12,10 +12,14 @@ import (
 	bor "github.com/maticnetwork/bor"
 	"github.com/maticnetwork/bor/core/types"
 	authTypes "github.com/maticnetwork/heimdall/auth/types"
+	"github.com/maticnetwork/heimdall/bridge/setu/metrics"
+	"github.com/maticnetwork/heimdall/bridge/setu/notify"
 	"github.com/maticnetwork/heimdall/bridge/setu/util"
 	"github.com/maticnetwork/heimdall/helper"
 
 	hmTypes "github.com/maticnetwork/heimdall/types"
+	"github.com/spf13/viper"
+	"github.com/syndtr/goleveldb/leveldb"
 	"github.com/tendermint/tendermint/libs/log"
 )
 
@@ -25,11 +29,22 @@ type TxBroadcaster struct {
 
 	cliCtx cliContext.CLIContext
 
-	heimdallMutex sync.Mutex
-	maticMutex    sync.Mutex
+	heimdallMutex  sync.Mutex
+	maticMutex     sync.Mutex
+	rootchainMutex sync.Mutex
+
+	// storage client (pending rootchain txs)
+	storageClient *leveldb.DB
+
+	// rootchain client and outcome callbacks of pending rootchain txs (by nonce)
+	mainClient           rootchainClient
+	rootchainTxCallbacks map[uint64][]RootchainTxCallback
 
 	lastSeqNo uint64
 	accNum    uint64
+
+	// shadow mode: record txs instead of sending them
+	shadow bool
 }
 
 // NewTxBroadcaster creates new broadcaster
@@ -47,10 +62,13 @@ func NewTxBroadcaster(cdc *codec.Codec) *TxBroadcaster {
 	}
 
 	txBroadcaster := TxBroadcaster{
-		logger:    util.Logger().With("module", "txBroadcaster"),
-		cliCtx:    cliCtx,
-		lastSeqNo: account.GetSequence(),
-		accNum:    account.GetAccountNumber(),
+		logger:        util.Logger().With("module", "txBroadcaster"),
+		cliCtx:        cliCtx,
+		lastSeqNo:     account.GetSequence(),
+		accNum:        account.GetAccountNumber(),
+		storageClient: util.GetBridgeDBInstance(viper.GetString(util.BridgeDBFlag)),
+		mainClient:    helper.GetMainClient(),
+		shadow:        viper.GetBool(ShadowFlag),
 	}
 
 	return &txBroadcaster
@@ -61,6 +79,10 @@ func (tb *TxBroadcaster) BroadcastToHeimdall(msg sdk.Msg) error {
 	tb.heimdallMutex.Lock()
 	defer tb.heimdallMutex.Unlock()
 
+	if tb.shadow {
+		return tb.recordHeimdallMsg(msg)
+	}
+
 	// tx encoder
 	txEncoder := helper.GetTxEncoder(tb.cliCtx.Codec)
 	// chain id
@@ -74,8 +96,10 @@ func (tb *TxBroadcaster) BroadcastToHeimdall(msg sdk.Msg) error {
 		WithChainID(chainID)
 
 	txResponse, err := helper.BuildAndBroadcastMsgs(tb.cliCtx, txBldr, []sdk.Msg{msg})
+	metrics.Broadcasts.WithLabelValues("heimdall", metrics.BroadcastStatus(err)).Inc()
 	if err != nil {
 		tb.logger.Error("Error while broadcasting the heimdall transaction", "error", err)
+		notify.Publish(notify.NewHeimdallTxFailedEvent(msg.Type(), err))
 
 		// current address
 		address := hmTypes.BytesToHeimdallAddress(helper.GetAddress())
@@ -105,6 +129,10 @@ func (tb *TxBroadcaster) BroadcastToMatic(msg bor.CallMsg) error {
 	tb.maticMutex.Lock()
 	defer tb.maticMutex.Unlock()
 
+	if tb.shadow {
+		return tb.recordCallMsg("bor", msg)
+	}
+
 	// get matic client
 	maticClient := helper.GetMaticClient()
 
@@ -129,7 +157,9 @@ func (tb *TxBroadcaster) BroadcastToMatic(msg bor.CallMsg) error {
 	tb.logger.Info("Sending transaction to bor", "txHash", signedTx.Hash())
 
 	// broadcast transaction
-	if err := maticClient.SendTransaction(context.Background(), signedTx); err != nil {
+	err = maticClient.SendTransaction(context.Background(), signedTx)
+	metrics.Broadcasts.WithLabelValues("bor", metrics.BroadcastStatus(err)).Inc()
+	if err != nil {
 		tb.logger.Error("Error while broadcasting the transaction to maticchain", "error", err)
 		return err
 	}
@@ -137,5 +167,32 @@ func (tb *TxBroadcaster) BroadcastToMatic(msg bor.CallMsg) error {
 	return nil
 }
 
-// BroadcastToRootchain broadcast to rootchain
-func (tb *TxBroadcaster) BroadcastToRootchain() {}
+// GetAccountSequence returns cached account number and sequence used for heimdall txs
+func (tb *TxBroadcaster) GetAccountSequence() (uint64, uint64) {
+	tb.heimdallMutex.Lock()
+	defer tb.heimdallMutex.Unlock()
+
+	return tb.accNum, tb.lastSeqNo
+}
+
+// ResyncAccountSequence fetches account from heimdall and resets cached account number and sequence
+func (tb *TxBroadcaster) ResyncAccountSequence() error {
+	tb.heimdallMutex.Lock()
+	defer tb.heimdallMutex.Unlock()
+
+	// current address
+	address := hmTypes.BytesToHeimdallAddress(helper.GetAddress())
+
+	// fetch from APIs
+	account, err := util.GetAccount(tb.cliCtx, address)
+	if err != nil {
+		tb.logger.Error("Error fetching account from rest-api", "url", helper.GetHeimdallServerEndpoint(fmt.Sprintf(util.AccountDetailsURL, helper.GetAddress())))
+		return err
+	}
+
+	tb.logger.Info("Resynced account sequence", "oldAccSeq", tb.lastSeqNo, "accSeq", account.GetSequence(), "accNum", account.GetAccountNumber())
+
+	tb.lastSeqNo = account.GetSequence()
+	tb.accNum = account.GetAccountNumber()
+	return nil
+}
@@ -2,6 +2,7 @@ package broadcaster
 
 import (
 	"os"
+	"strconv"
 	"testing"
 
 	"github.com/maticnetwork/heimdall/app"
@@ -35,7 +36,7 @@ func TestBroadcastToHeimdall(t *testing.T) {
 	}
 
 	for index, test := range testData {
-		t.Run(string(index), func(t *testing.T) {
+		t.Run(strconv.Itoa(index), func(t *testing.T) {
 			// create and send checkpoint message
 			msg := checkpointTypes.NewMsgCheckpointBlock(
 				test.Proposer,
@@ -43,6 +44,7 @@ func TestBroadcastToHeimdall(t *testing.T) {
 				test.EndBlock,
 				test.RootHash,
 				test.AccountRootHash,
+				test.BorChainID,
 			)
 
 			err := _txBroadcaster.BroadcastToHeimdall(msg)
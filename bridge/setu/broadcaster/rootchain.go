@@ -0,0 +1,459 @@
+package broadcaster
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"time"
+
+	bor "github.com/maticnetwork/bor"
+	"github.com/maticnetwork/bor/common"
+	"github.com/maticnetwork/bor/common/hexutil"
+	"github.com/maticnetwork/bor/core/types"
+	levelUtil "github.com/syndtr/goleveldb/leveldb/util"
+
+	"github.com/maticnetwork/heimdall/bridge/setu/metrics"
+	"github.com/maticnetwork/heimdall/bridge/setu/notify"
+	"github.com/maticnetwork/heimdall/bridge/setu/util"
+	"github.com/maticnetwork/heimdall/helper"
+)
+
+const (
+	rootchainTxKeyPrefix = "rootchain-tx-" // storage key prefix for rootchain txs (sorted by nonce)
+
+	// RootchainTxMonitorInterval is the interval at which pending rootchain txs are followed
+	RootchainTxMonitorInterval = 15 * time.Second
+	// RootchainTxResubmitTimeout is the time after which a pending rootchain tx is replaced with higher gas price
+	RootchainTxResubmitTimeout = 3 * time.Minute
+
+	// rootchainGasPriceBump is the percentage by which gas price is increased while replacing a tx
+	rootchainGasPriceBump = 10
+	// rootchainTxRetention is the time for which final txs are kept in bridge db
+	rootchainTxRetention = 24 * time.Hour
+)
+
+// RootchainTxStatus represents status of rootchain tx
+type RootchainTxStatus string
+
+const (
+	// RootchainTxPending tx is sent but not confirmed yet
+	RootchainTxPending RootchainTxStatus = "pending"
+	// RootchainTxConfirmed tx is mined with success status and has required confirmations
+	RootchainTxConfirmed RootchainTxStatus = "confirmed"
+	// RootchainTxFailed tx is mined with failed status and has required confirmations
+	RootchainTxFailed RootchainTxStatus = "failed"
+	// RootchainTxReplaced nonce is used by a tx which is not tracked by broadcaster
+	RootchainTxReplaced RootchainTxStatus = "replaced"
+	// RootchainTxShadow tx is only recorded as bridge runs in shadow mode
+	RootchainTxShadow RootchainTxStatus = "shadow"
+)
+
+var (
+	errRootchainTxReverted = errors.New("rootchain tx reverted")
+	errRootchainTxReplaced = errors.New("rootchain tx nonce used by another tx")
+)
+
+// RootchainTx represents rootchain tx tracked by broadcaster
+type RootchainTx struct {
+	Nonce    uint64         `json:"nonce"`
+	To       common.Address `json:"to"`
+	Data     hexutil.Bytes  `json:"data"`
+	Value    *big.Int       `json:"value"`
+	GasLimit uint64         `json:"gasLimit"`
+	GasPrice *big.Int       `json:"gasPrice"`
+
+	// latest tx hash and all tx hashes sent with this nonce
+	TxHash   common.Hash   `json:"txHash"`
+	TxHashes []common.Hash `json:"txHashes"`
+
+	Status      RootchainTxStatus `json:"status"`
+	BlockNumber uint64            `json:"blockNumber"`
+	SentAt      time.Time         `json:"sentAt"`
+	UpdatedAt   time.Time         `json:"updatedAt"`
+
+	// set once tx is stuck at max gas price and can't be replaced anymore
+	GasPriceCapped bool `json:"gasPriceCapped,omitempty"`
+}
+
+// IsFinal returns true if tx is not pending anymore
+func (tx *RootchainTx) IsFinal() bool {
+	return tx.Status != RootchainTxPending
+}
+
+// RootchainTxCallback is called with the outcome of a rootchain tx: once it is confirmed, failed or replaced,
+// and when it gets stuck at max gas price (status is still pending then).
+// Callbacks are kept in memory only, they are lost if bridge restarts before tx is final.
+type RootchainTxCallback func(tx RootchainTx)
+
+// rootchainClient is the part of rootchain client used by rootchain tx manager
+type rootchainClient interface {
+	PendingNonceAt(ctx context.Context, account common.Address) (uint64, error)
+	NonceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (uint64, error)
+	SuggestGasPrice(ctx context.Context) (*big.Int, error)
+	EstimateGas(ctx context.Context, msg bor.CallMsg) (uint64, error)
+	SendTransaction(ctx context.Context, tx *types.Transaction) error
+	TransactionReceipt(ctx context.Context, txHash common.Hash) (*types.Receipt, error)
+	HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error)
+}
+
+// BroadcastToRootchain broadcast to rootchain
+// Returns tracked tx right after broadcast, rootchain tx monitor follows it until it is final and calls callback (if any) with the outcome.
+func (tb *TxBroadcaster) BroadcastToRootchain(msg bor.CallMsg, callback RootchainTxCallback) (*RootchainTx, error) {
+	tb.rootchainMutex.Lock()
+	defer tb.rootchainMutex.Unlock()
+
+	if tb.shadow {
+		if err := tb.recordCallMsg("rootchain", msg); err != nil {
+			return nil, err
+		}
+		return &RootchainTx{To: *msg.To, Data: msg.Data, Value: msg.Value, Status: RootchainTxShadow}, nil
+	}
+
+	mainClient := tb.mainClient
+	fromAddress := common.BytesToAddress(helper.GetAddress())
+
+	// same tx is already pending
+	pendingTxs, err := tb.getRootchainTxs()
+	if err != nil {
+		return nil, err
+	}
+	for _, pendingTx := range pendingTxs {
+		if !pendingTx.IsFinal() && pendingTx.To == *msg.To && bytes.Equal(pendingTx.Data, msg.Data) {
+			tb.logger.Info("Same transaction is already pending on rootchain", "nonce", pendingTx.Nonce, "txHash", pendingTx.TxHash.Hex())
+			tb.addRootchainTxCallback(pendingTx.Nonce, callback)
+			return pendingTx, nil
+		}
+	}
+
+	// nonce
+	nonce, err := mainClient.PendingNonceAt(context.Background(), fromAddress)
+	if err != nil {
+		tb.logger.Error("Error fetching rootchain nonce", "error", err)
+		return nil, err
+	}
+	for _, pendingTx := range pendingTxs {
+		if !pendingTx.IsFinal() && pendingTx.Nonce >= nonce {
+			nonce = pendingTx.Nonce + 1
+		}
+	}
+
+	// gas price
+	gasPrice, err := mainClient.SuggestGasPrice(context.Background())
+	if err != nil {
+		tb.logger.Error("Error fetching rootchain gas price", "error", err)
+		return nil, err
+	}
+
+	// gas limit
+	msg.From = fromAddress
+	gasLimit, err := mainClient.EstimateGas(context.Background(), msg)
+	if err != nil {
+		tb.logger.Error("Unable to estimate gas", "error", err)
+		tb.logger.Info("Setting custom gaslimit", "gaslimit", helper.GetConfig().MainchainGasLimit)
+		gasLimit = helper.GetConfig().MainchainGasLimit
+	}
+
+	value := msg.Value
+	if value == nil {
+		value = big.NewInt(0)
+	}
+
+	tx := &RootchainTx{
+		Nonce:    nonce,
+		To:       *msg.To,
+		Data:     msg.Data,
+		Value:    value,
+		GasLimit: gasLimit,
+		Status:   RootchainTxPending,
+	}
+
+	if err := tb.sendRootchainTx(mainClient, tx, capGasPrice(gasPrice)); err != nil {
+		return nil, err
+	}
+	tb.addRootchainTxCallback(tx.Nonce, callback)
+
+	return tx, nil
+}
+
+// StartRootchainTxMonitor follows pending rootchain txs until they are final
+func (tb *TxBroadcaster) StartRootchainTxMonitor(ctx context.Context) {
+	ticker := time.NewTicker(RootchainTxMonitorInterval)
+	defer ticker.Stop()
+
+	tb.logger.Info("Start monitoring rootchain txs", "interval", RootchainTxMonitorInterval)
+	for {
+		select {
+		case <-ticker.C:
+			tb.processRootchainTxs()
+		case <-ctx.Done():
+			tb.logger.Info("Rootchain tx monitor stopped")
+			return
+		}
+	}
+}
+
+// processRootchainTxs updates status of pending rootchain txs and replaces stuck txs
+func (tb *TxBroadcaster) processRootchainTxs() {
+	tb.rootchainMutex.Lock()
+	defer tb.rootchainMutex.Unlock()
+
+	txs, err := tb.getRootchainTxs()
+	if err != nil {
+		tb.logger.Error("Error fetching rootchain txs from storage", "error", err)
+		return
+	}
+
+	var pendingTxs []*RootchainTx
+	for _, tx := range txs {
+		if !tx.IsFinal() {
+			pendingTxs = append(pendingTxs, tx)
+		} else if time.Since(tx.UpdatedAt) > rootchainTxRetention {
+			if err := tb.storageClient.Delete(rootchainTxKey(tx.Nonce), nil); err != nil {
+				tb.logger.Error("tb.storageClient.Delete", "Error", err)
+			}
+		}
+	}
+
+	if len(pendingTxs) == 0 {
+		return
+	}
+
+	chainmanagerParams, err := util.GetChainmanagerParams(tb.cliCtx)
+	if err != nil {
+		tb.logger.Error("Error fetching chainmanager params", "error", err)
+		return
+	}
+
+	mainClient := tb.mainClient
+	latestHeader, err := mainClient.HeaderByNumber(context.Background(), nil)
+	if err != nil {
+		tb.logger.Error("Error fetching latest rootchain header", "error", err)
+		return
+	}
+
+	// nonce of latest mined tx + 1
+	minedNonce, err := mainClient.NonceAt(context.Background(), common.BytesToAddress(helper.GetAddress()), nil)
+	if err != nil {
+		tb.logger.Error("Error fetching rootchain nonce", "error", err)
+		return
+	}
+
+	for _, tx := range pendingTxs {
+		tb.updateRootchainTx(mainClient, tx, latestHeader.Number.Uint64(), chainmanagerParams.MainchainTxConfirmations, minedNonce)
+	}
+}
+
+// updateRootchainTx follows receipts of pending tx and bumps gas price if tx is stuck
+func (tb *TxBroadcaster) updateRootchainTx(mainClient rootchainClient, tx *RootchainTx, latestBlock uint64, confirmations uint64, minedNonce uint64) {
+	for _, txHash := range tx.TxHashes {
+		receipt, err := mainClient.TransactionReceipt(context.Background(), txHash)
+		if err != nil || receipt == nil {
+			continue
+		}
+
+		tx.TxHash = txHash
+		tx.BlockNumber = receipt.BlockNumber.Uint64()
+
+		// wait for confirmations
+		if tx.BlockNumber+confirmations <= latestBlock {
+			if receipt.Status == types.ReceiptStatusSuccessful {
+				tx.Status = RootchainTxConfirmed
+				tb.logger.Info("Rootchain tx confirmed", "nonce", tx.Nonce, "txHash", txHash.Hex(), "blockNumber", tx.BlockNumber)
+			} else {
+				tx.Status = RootchainTxFailed
+				tb.logger.Error("Rootchain tx failed", "nonce", tx.Nonce, "txHash", txHash.Hex(), "blockNumber", tx.BlockNumber)
+				notify.Publish(notify.NewRootchainTxFailedEvent(tx.To, tx.Nonce, errRootchainTxReverted))
+			}
+		}
+
+		if err := tb.storeRootchainTx(tx); err != nil {
+			tb.logger.Error("Error storing rootchain tx", "nonce", tx.Nonce, "error", err)
+		}
+		if tx.IsFinal() {
+			tb.runRootchainTxCallbacks(tx)
+		}
+		return
+	}
+
+	// none of our txs is mined, but nonce is used
+	if minedNonce > tx.Nonce {
+		tx.Status = RootchainTxReplaced
+		tb.logger.Error("Rootchain tx replaced by another tx", "nonce", tx.Nonce, "txHash", tx.TxHash.Hex())
+		notify.Publish(notify.NewRootchainTxFailedEvent(tx.To, tx.Nonce, errRootchainTxReplaced))
+		if err := tb.storeRootchainTx(tx); err != nil {
+			tb.logger.Error("Error storing rootchain tx", "nonce", tx.Nonce, "error", err)
+		}
+		tb.runRootchainTxCallbacks(tx)
+		return
+	}
+
+	if time.Since(tx.SentAt) < RootchainTxResubmitTimeout {
+		return
+	}
+
+	// bump gas price and replace stuck tx
+	gasPrice := new(big.Int).Div(new(big.Int).Mul(tx.GasPrice, big.NewInt(100+rootchainGasPriceBump)), big.NewInt(100))
+	if suggestedGasPrice, err := mainClient.SuggestGasPrice(context.Background()); err == nil && suggestedGasPrice.Cmp(gasPrice) > 0 {
+		gasPrice = suggestedGasPrice
+	}
+	gasPrice = capGasPrice(gasPrice)
+
+	// replacement needs higher gas price, nothing to do but report once max gas price is reached
+	if gasPrice.Cmp(tx.GasPrice) <= 0 {
+		if !tx.GasPriceCapped {
+			tx.GasPriceCapped = true
+			tb.logger.Error("Rootchain tx is stuck at max gas price", "nonce", tx.Nonce, "txHash", tx.TxHash.Hex(), "gasPrice", tx.GasPrice)
+			notify.Publish(notify.NewRootchainTxStuckEvent(tx.To, tx.Nonce, tx.GasPrice))
+			if err := tb.storeRootchainTx(tx); err != nil {
+				tb.logger.Error("Error storing rootchain tx", "nonce", tx.Nonce, "error", err)
+			}
+			tb.runRootchainTxCallbacks(tx)
+		}
+		return
+	}
+
+	tb.logger.Info("Replacing stuck rootchain tx", "nonce", tx.Nonce, "txHash", tx.TxHash.Hex(), "oldGasPrice", tx.GasPrice, "newGasPrice", gasPrice)
+	if err := tb.sendRootchainTx(mainClient, tx, gasPrice); err != nil {
+		tb.logger.Error("Error replacing stuck rootchain tx", "nonce", tx.Nonce, "error", err)
+	}
+}
+
+// sendRootchainTx signs tx with given gas price, stores and broadcasts it
+func (tb *TxBroadcaster) sendRootchainTx(mainClient rootchainClient, tx *RootchainTx, gasPrice *big.Int) error {
+	auth, err := helper.GetTransactor()
+	if err != nil {
+		tb.logger.Error("Error getting rootchain transactor", "error", err)
+		return err
+	}
+
+	// Create the transaction, sign it and schedule it for execution
+	rawTx := types.NewTransaction(tx.Nonce, tx.To, tx.Value, tx.GasLimit, gasPrice, tx.Data)
+
+	// signer
+	signedTx, err := auth.Signer(types.HomesteadSigner{}, auth.From, rawTx)
+	if err != nil {
+		tb.logger.Error("Error signing the transaction", "error", err)
+		return err
+	}
+
+	// keep previous state to restore if broadcast fails
+	previousTx := *tx
+
+	tx.GasPrice = gasPrice
+	tx.TxHash = signedTx.Hash()
+	tx.TxHashes = append(tx.TxHashes, signedTx.Hash())
+	tx.SentAt = time.Now().UTC()
+
+	// store before broadcasting, so tx is followed even if bridge stops
+	if err := tb.storeRootchainTx(tx); err != nil {
+		tb.logger.Error("Error storing rootchain tx", "nonce", tx.Nonce, "error", err)
+		*tx = previousTx
+		return err
+	}
+
+	tb.logger.Info("Sending transaction to rootchain", "txHash", signedTx.Hash(), "nonce", tx.Nonce, "gasPrice", gasPrice)
+
+	// broadcast transaction
+	err = mainClient.SendTransaction(context.Background(), signedTx)
+	metrics.Broadcasts.WithLabelValues("rootchain", metrics.BroadcastStatus(err)).Inc()
+	if err != nil {
+		tb.logger.Error("Error while broadcasting the transaction to rootchain", "error", err)
+		notify.Publish(notify.NewRootchainTxFailedEvent(tx.To, tx.Nonce, err))
+
+		*tx = previousTx
+		if len(tx.TxHashes) == 0 {
+			if err := tb.storageClient.Delete(rootchainTxKey(tx.Nonce), nil); err != nil {
+				tb.logger.Error("tb.storageClient.Delete", "Error", err)
+			}
+		} else if err := tb.storeRootchainTx(tx); err != nil {
+			tb.logger.Error("Error storing rootchain tx", "nonce", tx.Nonce, "error", err)
+		}
+
+		return err
+	}
+
+	return nil
+}
+
+//
+// callbacks
+//
+
+func (tb *TxBroadcaster) addRootchainTxCallback(nonce uint64, callback RootchainTxCallback) {
+	if callback == nil {
+		return
+	}
+	if tb.rootchainTxCallbacks == nil {
+		tb.rootchainTxCallbacks = make(map[uint64][]RootchainTxCallback)
+	}
+	tb.rootchainTxCallbacks[nonce] = append(tb.rootchainTxCallbacks[nonce], callback)
+}
+
+// runRootchainTxCallbacks hands tx outcome to callbacks, they run in own goroutines as they may broadcast again.
+// Callbacks are dropped once tx is final.
+func (tb *TxBroadcaster) runRootchainTxCallbacks(tx *RootchainTx) {
+	callbacks := tb.rootchainTxCallbacks[tx.Nonce]
+	if tx.IsFinal() {
+		delete(tb.rootchainTxCallbacks, tx.Nonce)
+	}
+	for _, callback := range callbacks {
+		go callback(*tx)
+	}
+}
+
+//
+// storage
+//
+
+func (tb *TxBroadcaster) storeRootchainTx(tx *RootchainTx) error {
+	tx.UpdatedAt = time.Now().UTC()
+	txBytes, err := json.Marshal(tx)
+	if err != nil {
+		return err
+	}
+	return tb.storageClient.Put(rootchainTxKey(tx.Nonce), txBytes, nil)
+}
+
+// getRootchainTxs returns all stored rootchain txs sorted by nonce
+func (tb *TxBroadcaster) getRootchainTxs() ([]*RootchainTx, error) {
+	iter := tb.storageClient.NewIterator(levelUtil.BytesPrefix([]byte(rootchainTxKeyPrefix)), nil)
+	defer iter.Release()
+
+	var txs []*RootchainTx
+	for iter.Next() {
+		var tx RootchainTx
+		if err := json.Unmarshal(iter.Value(), &tx); err != nil {
+			return nil, err
+		}
+		txs = append(txs, &tx)
+	}
+
+	return txs, iter.Error()
+}
+
+//
+// utils
+//
+
+func rootchainTxKey(nonce uint64) []byte {
+	key := make([]byte, len(rootchainTxKeyPrefix)+8)
+	copy(key, rootchainTxKeyPrefix)
+	binary.BigEndian.PutUint64(key[len(rootchainTxKeyPrefix):], nonce)
+	return key
+}
+
+// capGasPrice caps gas price with configured max gas price
+func capGasPrice(gasPrice *big.Int) *big.Int {
+	maxGasPrice := helper.GetConfig().MainchainMaxGasPrice
+	if maxGasPrice <= 0 {
+		maxGasPrice = helper.DefaultMainchainMaxGasPrice
+	}
+
+	if gasPrice.Cmp(big.NewInt(maxGasPrice)) > 0 {
+		return big.NewInt(maxGasPrice)
+	}
+	return gasPrice
+}
@@ -0,0 +1,216 @@
+package broadcaster
+
+import (
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	bor "github.com/maticnetwork/bor"
+	"github.com/maticnetwork/bor/common"
+	"github.com/maticnetwork/bor/core/types"
+	"github.com/stretchr/testify/require"
+	"github.com/syndtr/goleveldb/leveldb"
+	"github.com/syndtr/goleveldb/leveldb/storage"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/maticnetwork/heimdall/helper"
+)
+
+// fakeRootchainClient is rootchain client which keeps sent txs and returns preset nonces, gas price and receipts
+type fakeRootchainClient struct {
+	pendingNonce uint64
+	gasPrice     *big.Int
+	latestBlock  uint64
+
+	sentTxs  []*types.Transaction
+	receipts map[common.Hash]*types.Receipt
+}
+
+func (c *fakeRootchainClient) PendingNonceAt(ctx context.Context, account common.Address) (uint64, error) {
+	return c.pendingNonce, nil
+}
+
+func (c *fakeRootchainClient) NonceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (uint64, error) {
+	return c.pendingNonce, nil
+}
+
+func (c *fakeRootchainClient) SuggestGasPrice(ctx context.Context) (*big.Int, error) {
+	return c.gasPrice, nil
+}
+
+func (c *fakeRootchainClient) EstimateGas(ctx context.Context, msg bor.CallMsg) (uint64, error) {
+	return 100000, nil
+}
+
+func (c *fakeRootchainClient) SendTransaction(ctx context.Context, tx *types.Transaction) error {
+	c.sentTxs = append(c.sentTxs, tx)
+	return nil
+}
+
+func (c *fakeRootchainClient) TransactionReceipt(ctx context.Context, txHash common.Hash) (*types.Receipt, error) {
+	receipt, ok := c.receipts[txHash]
+	if !ok {
+		return nil, bor.NotFound
+	}
+	return receipt, nil
+}
+
+func (c *fakeRootchainClient) HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error) {
+	return &types.Header{Number: new(big.Int).SetUint64(c.latestBlock)}, nil
+}
+
+func newTestRootchainBroadcaster(t *testing.T, client *fakeRootchainClient, maxGasPrice int64) *TxBroadcaster {
+	db, err := leveldb.Open(storage.NewMemStorage(), nil)
+	require.NoError(t, err)
+
+	signer, err := helper.NewPrivKeySigner(secp256k1.GenPrivKey())
+	require.NoError(t, err)
+	helper.SetSigner(signer)
+	helper.SetTestConfig(helper.Configuration{MainchainMaxGasPrice: maxGasPrice})
+
+	return &TxBroadcaster{
+		logger:        log.NewNopLogger(),
+		storageClient: db,
+		mainClient:    client,
+	}
+}
+
+func waitForRootchainTxOutcome(t *testing.T, outcomes chan RootchainTx) RootchainTx {
+	select {
+	case tx := <-outcomes:
+		return tx
+	case <-time.After(5 * time.Second):
+		t.Fatal("rootchain tx outcome is not reported")
+	}
+	return RootchainTx{}
+}
+
+func TestBroadcastToRootchainNonceAndDedup(t *testing.T) {
+	client := &fakeRootchainClient{pendingNonce: 5, gasPrice: big.NewInt(100)}
+	tb := newTestRootchainBroadcaster(t, client, 1000)
+
+	to := common.HexToAddress("0x1")
+	tx1, err := tb.BroadcastToRootchain(bor.CallMsg{To: &to, Data: []byte{1}}, nil)
+	require.NoError(t, err)
+	require.Equal(t, uint64(5), tx1.Nonce)
+	require.Equal(t, big.NewInt(100), tx1.GasPrice)
+
+	// node doesn't know about pending tx yet, next nonce comes from tracked txs
+	tx2, err := tb.BroadcastToRootchain(bor.CallMsg{To: &to, Data: []byte{2}}, nil)
+	require.NoError(t, err)
+	require.Equal(t, uint64(6), tx2.Nonce)
+
+	// same tx is not sent again, but its outcome is reported to new callback as well
+	outcomes := make(chan RootchainTx, 1)
+	tx3, err := tb.BroadcastToRootchain(bor.CallMsg{To: &to, Data: []byte{1}}, func(tx RootchainTx) { outcomes <- tx })
+	require.NoError(t, err)
+	require.Equal(t, tx1.Nonce, tx3.Nonce)
+	require.Equal(t, tx1.TxHash, tx3.TxHash)
+	require.Len(t, client.sentTxs, 2)
+
+	// tx is confirmed once it has required confirmations
+	client.receipts = map[common.Hash]*types.Receipt{
+		tx1.TxHash: {Status: types.ReceiptStatusSuccessful, BlockNumber: big.NewInt(10)},
+	}
+	tb.updateRootchainTx(client, tx3, 11, 2, 5)
+	require.Equal(t, RootchainTxPending, tx3.Status)
+
+	tb.updateRootchainTx(client, tx3, 12, 2, 5)
+	require.Equal(t, RootchainTxConfirmed, tx3.Status)
+
+	outcome := waitForRootchainTxOutcome(t, outcomes)
+	require.Equal(t, RootchainTxConfirmed, outcome.Status)
+	require.Equal(t, uint64(10), outcome.BlockNumber)
+
+	txs, err := tb.getRootchainTxs()
+	require.NoError(t, err)
+	require.Len(t, txs, 2)
+	require.Equal(t, RootchainTxConfirmed, txs[0].Status)
+	require.Equal(t, RootchainTxPending, txs[1].Status)
+}
+
+func TestRootchainTxGasPriceBump(t *testing.T) {
+	client := &fakeRootchainClient{pendingNonce: 1, gasPrice: big.NewInt(100)}
+	tb := newTestRootchainBroadcaster(t, client, 115)
+
+	outcomes := make(chan RootchainTx, 1)
+	to := common.HexToAddress("0x1")
+	tx, err := tb.BroadcastToRootchain(bor.CallMsg{To: &to, Data: []byte{1}}, func(tx RootchainTx) { outcomes <- tx })
+	require.NoError(t, err)
+
+	// not stuck yet
+	tb.updateRootchainTx(client, tx, 10, 0, 1)
+	require.Len(t, client.sentTxs, 1)
+
+	// stuck tx is replaced with 10% higher gas price and same nonce
+	tx.SentAt = time.Now().Add(-RootchainTxResubmitTimeout)
+	tb.updateRootchainTx(client, tx, 10, 0, 1)
+	require.Len(t, client.sentTxs, 2)
+	require.Equal(t, big.NewInt(110), tx.GasPrice)
+	require.Equal(t, big.NewInt(110), client.sentTxs[1].GasPrice())
+	require.Equal(t, uint64(1), client.sentTxs[1].Nonce())
+	require.Equal(t, client.sentTxs[1].Hash(), tx.TxHash)
+	require.Len(t, tx.TxHashes, 2)
+
+	// next bump is capped at max gas price
+	tx.SentAt = time.Now().Add(-RootchainTxResubmitTimeout)
+	tb.updateRootchainTx(client, tx, 10, 0, 1)
+	require.Len(t, client.sentTxs, 3)
+	require.Equal(t, big.NewInt(115), tx.GasPrice)
+
+	// tx stays at max gas price, stuck tx is reported once
+	for i := 0; i < 2; i++ {
+		tx.SentAt = time.Now().Add(-RootchainTxResubmitTimeout)
+		tb.updateRootchainTx(client, tx, 10, 0, 1)
+	}
+	require.Len(t, client.sentTxs, 3)
+	require.True(t, tx.GasPriceCapped)
+
+	outcome := waitForRootchainTxOutcome(t, outcomes)
+	require.Equal(t, RootchainTxPending, outcome.Status)
+	require.True(t, outcome.GasPriceCapped)
+	require.Len(t, outcomes, 0)
+
+	// replacement which is mined is followed as well
+	client.receipts = map[common.Hash]*types.Receipt{
+		tx.TxHashes[1]: {Status: types.ReceiptStatusFailed, BlockNumber: big.NewInt(10)},
+	}
+	tb.updateRootchainTx(client, tx, 10, 0, 2)
+	require.Equal(t, RootchainTxFailed, tx.Status)
+	require.Equal(t, tx.TxHashes[1], tx.TxHash)
+
+	outcome = waitForRootchainTxOutcome(t, outcomes)
+	require.Equal(t, RootchainTxFailed, outcome.Status)
+}
+
+func TestRootchainTxReplaced(t *testing.T) {
+	client := &fakeRootchainClient{pendingNonce: 3, gasPrice: big.NewInt(100)}
+	tb := newTestRootchainBroadcaster(t, client, 1000)
+
+	outcomes := make(chan RootchainTx, 1)
+	to := common.HexToAddress("0x1")
+	tx, err := tb.BroadcastToRootchain(bor.CallMsg{To: &to, Data: []byte{1}}, func(tx RootchainTx) { outcomes <- tx })
+	require.NoError(t, err)
+
+	// nonce is not used yet
+	tb.updateRootchainTx(client, tx, 10, 0, 3)
+	require.Equal(t, RootchainTxPending, tx.Status)
+
+	// nonce is used, but none of tracked txs is mined
+	tb.updateRootchainTx(client, tx, 10, 0, 4)
+	require.Equal(t, RootchainTxReplaced, tx.Status)
+
+	outcome := waitForRootchainTxOutcome(t, outcomes)
+	require.Equal(t, RootchainTxReplaced, outcome.Status)
+	require.Equal(t, uint64(3), outcome.Nonce)
+	require.Empty(t, tb.rootchainTxCallbacks)
+
+	// replaced tx is final, same tx can be sent again with new nonce
+	client.pendingNonce = 4
+	tx, err = tb.BroadcastToRootchain(bor.CallMsg{To: &to, Data: []byte{1}}, nil)
+	require.NoError(t, err)
+	require.Equal(t, uint64(4), tx.Nonce)
+	require.Len(t, client.sentTxs, 2)
+}
@@ -0,0 +1,302 @@
+package broadcaster
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	cliContext "github.com/cosmos/cosmos-sdk/client/context"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	bor "github.com/maticnetwork/bor"
+	"github.com/maticnetwork/bor/common"
+	"github.com/maticnetwork/bor/common/hexutil"
+	"github.com/syndtr/goleveldb/leveldb"
+	levelUtil "github.com/syndtr/goleveldb/leveldb/util"
+
+	borTypes "github.com/maticnetwork/heimdall/bor/types"
+	"github.com/maticnetwork/heimdall/bridge/setu/util"
+	checkpointTypes "github.com/maticnetwork/heimdall/checkpoint/types"
+	"github.com/maticnetwork/heimdall/helper"
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+const (
+	// ShadowFlag is the flag to run bridge in shadow mode
+	ShadowFlag = "shadow"
+
+	shadowTxKeyPrefix = "shadow-tx-" // storage key prefix for shadow records (sorted by time)
+)
+
+// ShadowVerdict represents result of comparing a shadow record with heimdall state
+type ShadowVerdict string
+
+const (
+	// ShadowMatched heimdall state contains what bridge would have sent
+	ShadowMatched ShadowVerdict = "matched"
+	// ShadowMissing heimdall state doesn't contain what bridge would have sent
+	ShadowMissing ShadowVerdict = "missing"
+	// ShadowMismatch heimdall state contains different data for the same event
+	ShadowMismatch ShadowVerdict = "mismatch"
+	// ShadowUnverified record can't be compared with heimdall state
+	ShadowUnverified ShadowVerdict = "unverified"
+	// ShadowError heimdall state couldn't be fetched
+	ShadowError ShadowVerdict = "error"
+)
+
+// mainTxMsg is implemented by msgs which are created from rootchain events
+type mainTxMsg interface {
+	GetTxHash() hmTypes.HeimdallHash
+	GetLogIndex() uint64
+}
+
+// ShadowRecord represents a tx which would have been sent in shadow mode
+type ShadowRecord struct {
+	Chain string    `json:"chain"`
+	Time  time.Time `json:"time"`
+
+	// heimdall msg
+	Route string          `json:"route,omitempty"`
+	Type  string          `json:"type,omitempty"`
+	Msg   json.RawMessage `json:"msg,omitempty"`
+
+	// rootchain/bor call
+	To    *common.Address `json:"to,omitempty"`
+	Data  hexutil.Bytes   `json:"data,omitempty"`
+	Value *hexutil.Big    `json:"value,omitempty"`
+}
+
+// ShadowReportEntry represents a shadow record along with its verdict
+type ShadowReportEntry struct {
+	ShadowRecord
+
+	Verdict ShadowVerdict `json:"verdict"`
+	Reason  string        `json:"reason,omitempty"`
+}
+
+// ShadowReport represents diff of shadow records against heimdall state
+type ShadowReport struct {
+	Total   int                   `json:"total"`
+	Summary map[ShadowVerdict]int `json:"summary"`
+	Entries []ShadowReportEntry   `json:"entries"`
+}
+
+// IsShadow returns true if broadcaster records txs instead of sending them
+func (tb *TxBroadcaster) IsShadow() bool {
+	return tb.shadow
+}
+
+// ShadowReport compares recorded txs with heimdall state
+func (tb *TxBroadcaster) ShadowReport() (*ShadowReport, error) {
+	return GetShadowReport(tb.cliCtx, tb.storageClient)
+}
+
+// recordHeimdallMsg stores heimdall msg which would have been broadcasted
+func (tb *TxBroadcaster) recordHeimdallMsg(msg sdk.Msg) error {
+	msgBytes, err := tb.cliCtx.Codec.MarshalJSON(msg)
+	if err != nil {
+		return err
+	}
+
+	tb.logger.Info("Shadow mode, recording heimdall tx", "route", msg.Route(), "type", msg.Type())
+	return storeShadowRecord(tb.storageClient, ShadowRecord{
+		Chain: "heimdall",
+		Time:  time.Now().UTC(),
+		Route: msg.Route(),
+		Type:  msg.Type(),
+		Msg:   msgBytes,
+	})
+}
+
+// recordCallMsg stores rootchain/bor call which would have been sent
+func (tb *TxBroadcaster) recordCallMsg(chain string, msg bor.CallMsg) error {
+	record := ShadowRecord{
+		Chain: chain,
+		Time:  time.Now().UTC(),
+		To:    msg.To,
+		Data:  msg.Data,
+	}
+	if msg.Value != nil {
+		record.Value = (*hexutil.Big)(msg.Value)
+	}
+
+	tb.logger.Info("Shadow mode, recording tx", "chain", chain, "to", msg.To)
+	return storeShadowRecord(tb.storageClient, record)
+}
+
+// GetShadowRecords returns all shadow records ordered by time
+func GetShadowRecords(storageClient *leveldb.DB) ([]ShadowRecord, error) {
+	iter := storageClient.NewIterator(levelUtil.BytesPrefix([]byte(shadowTxKeyPrefix)), nil)
+	defer iter.Release()
+
+	var records []ShadowRecord
+	for iter.Next() {
+		var record ShadowRecord
+		if err := json.Unmarshal(iter.Value(), &record); err != nil {
+			return nil, err
+		}
+		records = append(records, record)
+	}
+
+	return records, iter.Error()
+}
+
+// GetShadowReport compares shadow records with heimdall state
+func GetShadowReport(cliCtx cliContext.CLIContext, storageClient *leveldb.DB) (*ShadowReport, error) {
+	records, err := GetShadowRecords(storageClient)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &ShadowReport{
+		Total:   len(records),
+		Summary: make(map[ShadowVerdict]int),
+		Entries: make([]ShadowReportEntry, 0, len(records)),
+	}
+
+	for _, record := range records {
+		entry := ShadowReportEntry{ShadowRecord: record}
+		entry.Verdict, entry.Reason = verifyShadowRecord(cliCtx, record)
+
+		report.Summary[entry.Verdict]++
+		report.Entries = append(report.Entries, entry)
+	}
+
+	return report, nil
+}
+
+// verifyShadowRecord checks if heimdall already has what the record would have sent
+func verifyShadowRecord(cliCtx cliContext.CLIContext, record ShadowRecord) (ShadowVerdict, string) {
+	if record.Chain != "heimdall" {
+		return ShadowUnverified, fmt.Sprintf("%v txs are not compared with heimdall state", record.Chain)
+	}
+
+	var msg sdk.Msg
+	if err := cliCtx.Codec.UnmarshalJSON(record.Msg, &msg); err != nil {
+		return ShadowError, err.Error()
+	}
+
+	switch m := msg.(type) {
+	case checkpointTypes.MsgCheckpoint:
+		return verifyCheckpoint(cliCtx, m)
+	case checkpointTypes.MsgCheckpointAck:
+		return verifyCheckpointAck(cliCtx, m)
+	case borTypes.MsgProposeSpan:
+		return verifySpan(cliCtx, m)
+	case mainTxMsg:
+		return verifyMainTxMsg(cliCtx, record.Route, m)
+	default:
+		return ShadowUnverified, fmt.Sprintf("msg type %v is not compared with heimdall state", record.Type)
+	}
+}
+
+func verifyMainTxMsg(cliCtx cliContext.CLIContext, route string, msg mainTxMsg) (ShadowVerdict, string) {
+	txStatusURLs := map[string]string{
+		"staking":  util.StakingTxStatusURL,
+		"topup":    util.TopupTxStatusURL,
+		"clerk":    util.ClerkTxStatusURL,
+		"slashing": util.SlashingTxStatusURL,
+	}
+
+	endpoint, ok := txStatusURLs[route]
+	if !ok {
+		return ShadowUnverified, fmt.Sprintf("route %v has no tx status endpoint", route)
+	}
+
+	url, err := util.CreateURLWithQuery(helper.GetHeimdallServerEndpoint(endpoint), map[string]interface{}{
+		"txhash":   msg.GetTxHash().Hex(),
+		"logindex": msg.GetLogIndex(),
+	})
+	if err != nil {
+		return ShadowError, err.Error()
+	}
+
+	response, err := helper.FetchFromAPI(cliCtx, url)
+	if err != nil {
+		return ShadowError, err.Error()
+	}
+
+	var status bool
+	if err := json.Unmarshal(response.Result, &status); err != nil {
+		return ShadowError, err.Error()
+	}
+
+	if !status {
+		return ShadowMissing, fmt.Sprintf("tx %v log %v is not processed on heimdall", msg.GetTxHash().Hex(), msg.GetLogIndex())
+	}
+	return ShadowMatched, ""
+}
+
+func verifyCheckpoint(cliCtx cliContext.CLIContext, msg checkpointTypes.MsgCheckpoint) (ShadowVerdict, string) {
+	checkpoints := make([]*hmTypes.Checkpoint, 0, 2)
+	if checkpoint, err := util.GetBufferedCheckpoint(cliCtx); err == nil {
+		checkpoints = append(checkpoints, checkpoint)
+	}
+	if checkpoint, err := util.GetlastestCheckpoint(cliCtx); err == nil {
+		checkpoints = append(checkpoints, checkpoint)
+	}
+
+	for _, checkpoint := range checkpoints {
+		if checkpoint.StartBlock != msg.StartBlock {
+			continue
+		}
+
+		if checkpoint.EndBlock != msg.EndBlock || checkpoint.RootHash != msg.RootHash {
+			return ShadowMismatch, fmt.Sprintf("heimdall has checkpoint %v-%v with root %v", checkpoint.StartBlock, checkpoint.EndBlock, checkpoint.RootHash.Hex())
+		}
+		return ShadowMatched, ""
+	}
+
+	return ShadowMissing, fmt.Sprintf("checkpoint %v-%v is neither buffered nor latest", msg.StartBlock, msg.EndBlock)
+}
+
+func verifyCheckpointAck(cliCtx cliContext.CLIContext, msg checkpointTypes.MsgCheckpointAck) (ShadowVerdict, string) {
+	response, err := helper.FetchFromAPI(cliCtx, helper.GetHeimdallServerEndpoint(fmt.Sprintf(util.CheckpointURL, msg.Number)))
+	if err != nil {
+		return ShadowMissing, fmt.Sprintf("checkpoint %v is not acked on heimdall: %v", msg.Number, err)
+	}
+
+	var checkpoint hmTypes.Checkpoint
+	if err := json.Unmarshal(response.Result, &checkpoint); err != nil {
+		return ShadowError, err.Error()
+	}
+
+	if checkpoint.StartBlock != msg.StartBlock || checkpoint.EndBlock != msg.EndBlock || checkpoint.RootHash != msg.RootHash {
+		return ShadowMismatch, fmt.Sprintf("heimdall has checkpoint %v as %v-%v with root %v", msg.Number, checkpoint.StartBlock, checkpoint.EndBlock, checkpoint.RootHash.Hex())
+	}
+	return ShadowMatched, ""
+}
+
+func verifySpan(cliCtx cliContext.CLIContext, msg borTypes.MsgProposeSpan) (ShadowVerdict, string) {
+	response, err := helper.FetchFromAPI(cliCtx, helper.GetHeimdallServerEndpoint(fmt.Sprintf(util.SpanURL, msg.ID)))
+	if err != nil {
+		return ShadowMissing, fmt.Sprintf("span %v is not found on heimdall: %v", msg.ID, err)
+	}
+
+	var span hmTypes.Span
+	if err := json.Unmarshal(response.Result, &span); err != nil {
+		return ShadowError, err.Error()
+	}
+
+	if span.StartBlock != msg.StartBlock || span.EndBlock != msg.EndBlock {
+		return ShadowMismatch, fmt.Sprintf("heimdall has span %v as %v-%v", msg.ID, span.StartBlock, span.EndBlock)
+	}
+	return ShadowMatched, ""
+}
+
+//
+// utils
+//
+
+func storeShadowRecord(storageClient *leveldb.DB, record ShadowRecord) error {
+	value, err := json.Marshal(record)
+	if err != nil {
+		return err
+	}
+
+	key := make([]byte, 8)
+	binary.BigEndian.PutUint64(key, uint64(record.Time.UnixNano()))
+	key = append(append([]byte(shadowTxKeyPrefix), key...), []byte(record.Chain)...)
+
+	return storageClient.Put(key, value, nil)
+}
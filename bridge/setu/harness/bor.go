@@ -0,0 +1,93 @@
+package harness
+
+import (
+	"encoding/hex"
+	"fmt"
+	"math/big"
+
+	"github.com/maticnetwork/bor/common"
+	"github.com/maticnetwork/bor/core/types"
+	ethCrypto "github.com/maticnetwork/bor/crypto"
+)
+
+// maxCheckpointLength is the max number of headers bor computes root hash for
+const maxCheckpointLength = 1024
+
+// FakeBor is a simulated chain serving bor specific json-rpc methods
+type FakeBor struct {
+	*SimulatedChain
+}
+
+// NewFakeBor creates fake bor chain with given accounts funded
+func NewFakeBor(accounts ...common.Address) (*FakeBor, error) {
+	chain, err := NewSimulatedChain("bor", accounts...)
+	if err != nil {
+		return nil, err
+	}
+
+	fakeBor := &FakeBor{SimulatedChain: chain}
+	if err := chain.RegisterService("eth", &borAPI{chain: chain}); err != nil {
+		return nil, err
+	}
+
+	return fakeBor, nil
+}
+
+// borAPI serves bor specific methods in eth namespace
+type borAPI struct {
+	chain *SimulatedChain
+}
+
+// GetRootHash returns merkle root of headers from start to end, same as bor
+func (api *borAPI) GetRootHash(start uint64, end uint64) (string, error) {
+	latest := api.chain.LatestHeader().Number.Uint64()
+	if start > end || end > latest {
+		return "", fmt.Errorf("Invalid start end block (start: %v, end: %v, latest: %v)", start, end, latest)
+	}
+
+	if end-start+1 > maxCheckpointLength {
+		return "", fmt.Errorf("Max checkpoint length exceeded (start: %v, end: %v)", start, end)
+	}
+
+	headers := make([]*types.Header, 0, end-start+1)
+	for number := start; number <= end; number++ {
+		headers = append(headers, api.chain.Backend.Blockchain().GetHeaderByNumber(number))
+	}
+
+	return hex.EncodeToString(headerRootHash(headers)), nil
+}
+
+//
+// utils
+//
+
+// headerRootHash returns root of merkle tree of header hashes, padded with empty leaves to power of two
+func headerRootHash(headers []*types.Header) []byte {
+	length := uint64(1)
+	for length < uint64(len(headers)) {
+		length *= 2
+	}
+
+	leaves := make([][]byte, length)
+	for i := range leaves {
+		leaves[i] = make([]byte, common.HashLength)
+		if i < len(headers) {
+			leaves[i] = ethCrypto.Keccak256(
+				common.LeftPadBytes(headers[i].Number.Bytes(), 32),
+				common.LeftPadBytes(new(big.Int).SetUint64(headers[i].Time).Bytes(), 32),
+				headers[i].TxHash.Bytes(),
+				headers[i].ReceiptHash.Bytes(),
+			)
+		}
+	}
+
+	for len(leaves) > 1 {
+		next := make([][]byte, len(leaves)/2)
+		for i := range next {
+			next[i] = ethCrypto.Keccak256(leaves[2*i], leaves[2*i+1])
+		}
+		leaves = next
+	}
+
+	return leaves[0]
+}
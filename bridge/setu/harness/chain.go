@@ -0,0 +1,256 @@
+package harness
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"fmt"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/maticnetwork/bor/accounts/abi/bind/backends"
+	"github.com/maticnetwork/bor/common"
+	"github.com/maticnetwork/bor/core"
+	"github.com/maticnetwork/bor/core/rawdb"
+	"github.com/maticnetwork/bor/core/types"
+	ethCrypto "github.com/maticnetwork/bor/crypto"
+	"github.com/maticnetwork/bor/ethclient"
+	"github.com/maticnetwork/bor/ethdb"
+	"github.com/maticnetwork/bor/params"
+	"github.com/maticnetwork/bor/rpc"
+)
+
+const (
+	simulatedGasLimit = uint64(10000000)
+	operatorTxGas     = uint64(1000000)
+)
+
+// operatorBalance is the balance of operator and funded accounts on simulated chain (1M ether)
+var operatorBalance = new(big.Int).Mul(big.NewInt(1000000), big.NewInt(params.Ether))
+
+// SimulatedChain is an in-process ethereum chain served over json-rpc, so that
+// clients used by bridge and heimdall (ethclient) can be pointed to it.
+// Every transaction is mined in its own block.
+type SimulatedChain struct {
+	Name    string
+	Backend *backends.SimulatedBackend
+
+	// operator deploys contract stubs and emits events
+	Operator    common.Address
+	operatorKey *ecdsa.PrivateKey
+
+	database ethdb.Database
+	config   *params.ChainConfig
+
+	// serializes nonce, send and commit of transactions
+	txMutex sync.Mutex
+
+	contractsMutex sync.RWMutex
+	contracts      map[common.Address]*Contract
+
+	server *rpc.Server
+	client *rpc.Client
+}
+
+// NewSimulatedChain creates simulated chain with given accounts funded
+func NewSimulatedChain(name string, accounts ...common.Address) (*SimulatedChain, error) {
+	operatorKey, err := ethCrypto.GenerateKey()
+	if err != nil {
+		return nil, err
+	}
+
+	operator := ethCrypto.PubkeyToAddress(operatorKey.PublicKey)
+	alloc := core.GenesisAlloc{operator: {Balance: operatorBalance}}
+	for _, account := range accounts {
+		alloc[account] = core.GenesisAccount{Balance: operatorBalance}
+	}
+
+	database := rawdb.NewMemoryDatabase()
+	chain := &SimulatedChain{
+		Name:        name,
+		Backend:     backends.NewSimulatedBackendWithDatabase(database, alloc, simulatedGasLimit),
+		Operator:    operator,
+		operatorKey: operatorKey,
+		database:    database,
+		config:      params.AllEthashProtocolChanges,
+		contracts:   make(map[common.Address]*Contract),
+		server:      rpc.NewServer(),
+	}
+
+	if err := chain.server.RegisterName("eth", &ethAPI{chain: chain}); err != nil {
+		return nil, err
+	}
+	if err := chain.server.RegisterName("net", &netAPI{chain: chain}); err != nil {
+		return nil, err
+	}
+
+	chain.client = rpc.DialInProc(chain.server)
+	return chain, nil
+}
+
+// ChainID returns chain id of simulated chain
+func (c *SimulatedChain) ChainID() *big.Int {
+	return c.config.ChainID
+}
+
+// RPCClient returns json-rpc client connected to the chain
+func (c *SimulatedChain) RPCClient() *rpc.Client {
+	return c.client
+}
+
+// Client returns eth client connected to the chain
+func (c *SimulatedChain) Client() *ethclient.Client {
+	return ethclient.NewClient(c.client)
+}
+
+// RegisterService registers additional json-rpc service (e.g. chain specific methods in eth namespace)
+func (c *SimulatedChain) RegisterService(namespace string, service interface{}) error {
+	return c.server.RegisterName(namespace, service)
+}
+
+// DeployContract deploys contract stub with given ABI
+func (c *SimulatedChain) DeployContract(name string, abiJSON string) (*Contract, error) {
+	c.txMutex.Lock()
+	defer c.txMutex.Unlock()
+
+	nonce, err := c.Backend.PendingNonceAt(context.Background(), c.Operator)
+	if err != nil {
+		return nil, err
+	}
+
+	tx := types.NewContractCreation(nonce, big.NewInt(0), operatorTxGas, big.NewInt(1), emitterCode())
+	receipt, err := c.sendOperatorTx(tx)
+	if err != nil {
+		return nil, err
+	}
+
+	contract, err := newContract(c, name, receipt.ContractAddress, abiJSON)
+	if err != nil {
+		return nil, err
+	}
+
+	c.contractsMutex.Lock()
+	c.contracts[contract.Address] = contract
+	c.contractsMutex.Unlock()
+
+	return contract, nil
+}
+
+// Transact sends operator transaction with given data and returns its receipt
+func (c *SimulatedChain) Transact(to common.Address, data []byte) (*types.Receipt, error) {
+	c.txMutex.Lock()
+	defer c.txMutex.Unlock()
+
+	nonce, err := c.Backend.PendingNonceAt(context.Background(), c.Operator)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.sendOperatorTx(types.NewTransaction(nonce, to, big.NewInt(0), operatorTxGas, big.NewInt(1), data))
+}
+
+// SendTransaction adds signed transaction to the chain and mines a block
+func (c *SimulatedChain) SendTransaction(tx *types.Transaction) (err error) {
+	c.txMutex.Lock()
+	defer c.txMutex.Unlock()
+
+	return c.sendTransaction(tx)
+}
+
+// Mine mines n empty blocks
+func (c *SimulatedChain) Mine(n int) {
+	c.txMutex.Lock()
+	defer c.txMutex.Unlock()
+
+	for i := 0; i < n; i++ {
+		c.Backend.Commit()
+	}
+}
+
+// AdjustTime moves time of the next block
+func (c *SimulatedChain) AdjustTime(adjustment time.Duration) error {
+	c.txMutex.Lock()
+	defer c.txMutex.Unlock()
+
+	if err := c.Backend.AdjustTime(adjustment); err != nil {
+		return err
+	}
+
+	c.Backend.Commit()
+	return nil
+}
+
+// LatestHeader returns header of the latest block
+func (c *SimulatedChain) LatestHeader() *types.Header {
+	return c.Backend.Blockchain().CurrentHeader()
+}
+
+// Close closes rpc client and server
+func (c *SimulatedChain) Close() {
+	c.client.Close()
+	c.server.Stop()
+}
+
+//
+// utils
+//
+
+func (c *SimulatedChain) contract(address common.Address) (*Contract, bool) {
+	c.contractsMutex.RLock()
+	defer c.contractsMutex.RUnlock()
+
+	contract, ok := c.contracts[address]
+	return contract, ok
+}
+
+func (c *SimulatedChain) signer(number *big.Int) types.Signer {
+	return types.MakeSigner(c.config, number)
+}
+
+func (c *SimulatedChain) sendOperatorTx(tx *types.Transaction) (*types.Receipt, error) {
+	signedTx, err := types.SignTx(tx, types.NewEIP155Signer(c.config.ChainID), c.operatorKey)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := c.sendTransaction(signedTx); err != nil {
+		return nil, err
+	}
+
+	receipt, err := c.Backend.TransactionReceipt(context.Background(), signedTx.Hash())
+	if err != nil {
+		return nil, err
+	}
+
+	if receipt == nil || receipt.Status != types.ReceiptStatusSuccessful {
+		return nil, fmt.Errorf("%v: operator tx %v failed", c.Name, signedTx.Hash().Hex())
+	}
+
+	return receipt, nil
+}
+
+// sendTransaction sends transaction and mines it, simulated backend panics on invalid txs
+func (c *SimulatedChain) sendTransaction(tx *types.Transaction) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("%v", r)
+		}
+	}()
+
+	if err := c.Backend.SendTransaction(context.Background(), tx); err != nil {
+		return err
+	}
+
+	c.Backend.Commit()
+	return nil
+}
+
+// blockByNumber returns block by number (latest for negative numbers), nil if not found
+func (c *SimulatedChain) blockByNumber(number int64) *types.Block {
+	blockchain := c.Backend.Blockchain()
+	if number < 0 {
+		return blockchain.CurrentBlock()
+	}
+
+	return blockchain.GetBlockByNumber(uint64(number))
+}
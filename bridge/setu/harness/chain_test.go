@@ -0,0 +1,80 @@
+package harness
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/maticnetwork/bor/common"
+	"github.com/maticnetwork/bor/core/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/maticnetwork/heimdall/contracts/rootchain"
+	"github.com/maticnetwork/heimdall/helper"
+)
+
+func TestRootChainStub(t *testing.T) {
+	chain, err := NewSimulatedChain("root")
+	require.NoError(t, err)
+	defer chain.Close()
+
+	rc, err := DeployRootChain(chain, 10000)
+	require.NoError(t, err)
+
+	proposer := common.HexToAddress("0x1")
+	root := common.HexToHash("0x2")
+	vLog, err := rc.SubmitCheckpoint(proposer, 0, 255, root)
+	require.NoError(t, err)
+
+	// event is unpacked the way bridge does
+	event := new(rootchain.RootchainNewHeaderBlock)
+	require.NoError(t, helper.UnpackLog(&rc.ABI, event, "NewHeaderBlock", vLog))
+	require.Equal(t, proposer, event.Proposer)
+	require.Equal(t, uint64(10000), event.HeaderBlockId.Uint64())
+	require.Equal(t, uint64(255), event.End.Uint64())
+	require.Equal(t, [32]byte(root), event.Root)
+
+	// receipt and logs are served over json-rpc
+	receipt, err := chain.Client().TransactionReceipt(context.Background(), vLog.TxHash)
+	require.NoError(t, err)
+	require.Equal(t, vLog.BlockNumber, receipt.BlockNumber.Uint64())
+
+	// calls are answered by stub
+	instance, err := rootchain.NewRootchain(rc.Address, chain.Client())
+	require.NoError(t, err)
+
+	headerBlock, err := instance.HeaderBlocks(nil, big.NewInt(10000))
+	require.NoError(t, err)
+	require.Equal(t, [32]byte(root), headerBlock.Root)
+	require.Equal(t, proposer, headerBlock.Proposer)
+
+	current, err := instance.CurrentHeaderBlock(nil)
+	require.NoError(t, err)
+	require.Equal(t, uint64(20000), current.Uint64())
+
+	lastChildBlock, err := instance.GetLastChildBlock(nil)
+	require.NoError(t, err)
+	require.Equal(t, uint64(255), lastChildBlock.Uint64())
+}
+
+func TestFakeBorRootHash(t *testing.T) {
+	bor, err := NewFakeBor()
+	require.NoError(t, err)
+	defer bor.Close()
+
+	bor.Mine(10)
+
+	contractCaller := helper.ContractCaller{MaticChainClient: bor.Client()}
+	rootHash, err := contractCaller.GetRootHash(1, 5, 1024)
+	require.NoError(t, err)
+
+	headers := make([]*types.Header, 0, 5)
+	for number := uint64(1); number <= 5; number++ {
+		headers = append(headers, bor.Backend.Blockchain().GetHeaderByNumber(number))
+	}
+	require.Equal(t, headerRootHash(headers), rootHash)
+
+	// end block is not mined yet
+	_, err = contractCaller.GetRootHash(1, 20, 1024)
+	require.Error(t, err)
+}
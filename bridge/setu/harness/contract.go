@@ -0,0 +1,160 @@
+package harness
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+	"sync"
+
+	"github.com/maticnetwork/bor/accounts/abi"
+	"github.com/maticnetwork/bor/common"
+	"github.com/maticnetwork/bor/core/types"
+)
+
+// CallHandler answers eth_call of a contract method with unpacked inputs
+type CallHandler func(args []interface{}) ([]interface{}, error)
+
+// Contract is a contract stub deployed on simulated chain.
+//
+// Generated bindings in contracts/ carry only ABIs, so stubs are deployed with a tiny log emitter code.
+// Events are packed with contract ABI and emitted on chain (so they end up in real receipts and logs),
+// while calls to contract methods are answered by registered handlers.
+type Contract struct {
+	Name    string
+	Address common.Address
+	ABI     abi.ABI
+
+	chain *SimulatedChain
+
+	handlersMutex sync.RWMutex
+	handlers      map[string]CallHandler
+}
+
+func newContract(chain *SimulatedChain, name string, address common.Address, abiJSON string) (*Contract, error) {
+	contractABI, err := abi.JSON(strings.NewReader(abiJSON))
+	if err != nil {
+		return nil, err
+	}
+
+	return &Contract{
+		Name:     name,
+		Address:  address,
+		ABI:      contractABI,
+		chain:    chain,
+		handlers: make(map[string]CallHandler),
+	}, nil
+}
+
+// HandleCall registers handler for contract method
+func (c *Contract) HandleCall(method string, handler CallHandler) {
+	c.handlersMutex.Lock()
+	defer c.handlersMutex.Unlock()
+
+	c.handlers[method] = handler
+}
+
+// Emit packs event with given args (in ABI order) and emits it from contract address in a new block.
+// Returns emitted log with receipt data (tx hash, block number and log index).
+func (c *Contract) Emit(eventName string, args ...interface{}) (*types.Log, error) {
+	data, err := c.packEvent(eventName, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	receipt, err := c.chain.Transact(c.Address, data)
+	if err != nil {
+		return nil, err
+	}
+
+	// bor also adds fee transfer logs to receipts
+	for _, vLog := range receipt.Logs {
+		if vLog.Address == c.Address {
+			return vLog, nil
+		}
+	}
+
+	return nil, fmt.Errorf("%v: event %v not emitted", c.Name, eventName)
+}
+
+// call answers eth_call data using registered handlers
+func (c *Contract) call(data []byte) ([]byte, bool, error) {
+	if len(data) < 4 {
+		return nil, false, nil
+	}
+
+	method, err := c.ABI.MethodById(data[:4])
+	if err != nil {
+		return nil, false, nil
+	}
+
+	c.handlersMutex.RLock()
+	handler, ok := c.handlers[method.Name]
+	c.handlersMutex.RUnlock()
+	if !ok {
+		return nil, false, nil
+	}
+
+	inputs, err := method.Inputs.UnpackValues(data[4:])
+	if err != nil {
+		return nil, true, err
+	}
+
+	outputs, err := handler(inputs)
+	if err != nil {
+		return nil, true, err
+	}
+
+	result, err := method.Outputs.PackValues(outputs)
+	return result, true, err
+}
+
+// packEvent returns emitter call data: number of topics, 4 topic slots and non-indexed data
+func (c *Contract) packEvent(eventName string, args ...interface{}) ([]byte, error) {
+	event, ok := c.ABI.Events[eventName]
+	if !ok {
+		return nil, fmt.Errorf("%v: event %v not found", c.Name, eventName)
+	}
+
+	if len(args) != len(event.Inputs) {
+		return nil, fmt.Errorf("%v: event %v has %v inputs, got %v args", c.Name, eventName, len(event.Inputs), len(args))
+	}
+
+	topics := []common.Hash{event.Id()}
+	var values []interface{}
+	for i, input := range event.Inputs {
+		if !input.Indexed {
+			values = append(values, args[i])
+			continue
+		}
+
+		topic, err := abi.Arguments{{Type: input.Type}}.Pack(args[i])
+		if err != nil {
+			return nil, err
+		}
+		if len(topic) != common.HashLength {
+			return nil, errors.New("dynamic indexed event inputs are not supported")
+		}
+		topics = append(topics, common.BytesToHash(topic))
+	}
+
+	if len(topics) > maxEmitterTopics {
+		return nil, fmt.Errorf("%v: event %v has too many topics", c.Name, eventName)
+	}
+
+	data, err := event.Inputs.NonIndexed().Pack(values...)
+	if err != nil {
+		return nil, err
+	}
+
+	result := common.LeftPadBytes(big.NewInt(int64(len(topics))).Bytes(), common.HashLength)
+	for i := 0; i < maxEmitterTopics; i++ {
+		var topic common.Hash
+		if i < len(topics) {
+			topic = topics[i]
+		}
+		result = append(result, topic.Bytes()...)
+	}
+
+	return append(result, data...), nil
+}
@@ -0,0 +1,77 @@
+package harness
+
+import (
+	"github.com/maticnetwork/bor/core/vm"
+)
+
+// maxEmitterTopics is the max number of topics emitted by a single LOG opcode
+const maxEmitterTopics = 4
+
+// emitterTopicsOffset is the offset of log data in emitter call data
+const emitterTopicsOffset = 32 * (maxEmitterTopics + 1)
+
+// emitterCode returns creation code of log emitter contract.
+//
+// Emitter takes call data as [number of topics (32 bytes) | 4 topics (32 bytes each) | log data]
+// and emits log with given topics and data. Calls with any other number of topics (e.g. calls
+// to contract methods, where first word starts with method selector) are accepted without logs.
+func emitterCode() []byte {
+	runtime := []byte{
+		byte(vm.PUSH1), 0,
+		byte(vm.CALLDATALOAD),
+	}
+
+	// jump to LOGn for n topics
+	jumps := make([]int, maxEmitterTopics+1)
+	for n := 0; n <= maxEmitterTopics; n++ {
+		runtime = append(runtime,
+			byte(vm.DUP1),
+			byte(vm.PUSH1), byte(n),
+			byte(vm.EQ),
+			byte(vm.PUSH1), 0, // destination, filled below
+			byte(vm.JUMPI),
+		)
+		jumps[n] = len(runtime) - 2
+	}
+	runtime = append(runtime, byte(vm.STOP))
+
+	for n := 0; n <= maxEmitterTopics; n++ {
+		runtime[jumps[n]] = byte(len(runtime))
+		runtime = append(runtime, byte(vm.JUMPDEST))
+
+		// push topics, first topic on top
+		for i := n - 1; i >= 0; i-- {
+			runtime = append(runtime,
+				byte(vm.PUSH1), byte(32*(i+1)),
+				byte(vm.CALLDATALOAD),
+			)
+		}
+
+		// copy log data to memory
+		runtime = append(runtime,
+			byte(vm.PUSH1), emitterTopicsOffset,
+			byte(vm.CALLDATASIZE),
+			byte(vm.SUB),
+			byte(vm.DUP1),
+			byte(vm.PUSH1), emitterTopicsOffset,
+			byte(vm.PUSH1), 0,
+			byte(vm.CALLDATACOPY),
+			byte(vm.PUSH1), 0,
+			byte(vm.LOG0)+byte(n),
+			byte(vm.STOP),
+		)
+	}
+
+	// creation code returns runtime code
+	creation := []byte{
+		byte(vm.PUSH1), byte(len(runtime)),
+		byte(vm.DUP1),
+		byte(vm.PUSH1), 11, // length of creation code
+		byte(vm.PUSH1), 0,
+		byte(vm.CODECOPY),
+		byte(vm.PUSH1), 0,
+		byte(vm.RETURN),
+	}
+
+	return append(creation, runtime...)
+}
@@ -0,0 +1,237 @@
+package harness
+
+import (
+	"context"
+	"encoding/json"
+	"math/big"
+
+	ethereum "github.com/maticnetwork/bor"
+	"github.com/maticnetwork/bor/common"
+	"github.com/maticnetwork/bor/common/hexutil"
+	"github.com/maticnetwork/bor/core/rawdb"
+	"github.com/maticnetwork/bor/core/types"
+	"github.com/maticnetwork/bor/eth/filters"
+	"github.com/maticnetwork/bor/rlp"
+	"github.com/maticnetwork/bor/rpc"
+)
+
+// CallArgs represents arguments of eth_call and eth_estimateGas
+type CallArgs struct {
+	From     *common.Address `json:"from"`
+	To       *common.Address `json:"to"`
+	Gas      *hexutil.Uint64 `json:"gas"`
+	GasPrice *hexutil.Big    `json:"gasPrice"`
+	Value    *hexutil.Big    `json:"value"`
+	Data     *hexutil.Bytes  `json:"data"`
+}
+
+func (args CallArgs) toCallMsg() ethereum.CallMsg {
+	msg := ethereum.CallMsg{To: args.To}
+	if args.From != nil {
+		msg.From = *args.From
+	}
+	if args.Gas != nil {
+		msg.Gas = uint64(*args.Gas)
+	}
+	if args.GasPrice != nil {
+		msg.GasPrice = args.GasPrice.ToInt()
+	}
+	if args.Value != nil {
+		msg.Value = args.Value.ToInt()
+	}
+	if args.Data != nil {
+		msg.Data = *args.Data
+	}
+	return msg
+}
+
+// ethAPI serves subset of eth namespace used by bridge and heimdall on top of simulated backend
+type ethAPI struct {
+	chain *SimulatedChain
+}
+
+// ChainId returns chain id
+func (api *ethAPI) ChainId() *hexutil.Big {
+	return (*hexutil.Big)(api.chain.ChainID())
+}
+
+// BlockNumber returns latest block number
+func (api *ethAPI) BlockNumber() hexutil.Uint64 {
+	return hexutil.Uint64(api.chain.LatestHeader().Number.Uint64())
+}
+
+// GetBlockByNumber returns block by number
+func (api *ethAPI) GetBlockByNumber(number rpc.BlockNumber, fullTx bool) (map[string]interface{}, error) {
+	block := api.chain.blockByNumber(number.Int64())
+	if block == nil {
+		return nil, nil
+	}
+
+	return api.marshalBlock(block, fullTx)
+}
+
+// GetBlockByHash returns block by hash
+func (api *ethAPI) GetBlockByHash(hash common.Hash, fullTx bool) (map[string]interface{}, error) {
+	block := api.chain.Backend.Blockchain().GetBlockByHash(hash)
+	if block == nil {
+		return nil, nil
+	}
+
+	return api.marshalBlock(block, fullTx)
+}
+
+// GetTransactionByHash returns transaction by hash
+func (api *ethAPI) GetTransactionByHash(hash common.Hash) (map[string]interface{}, error) {
+	tx, blockHash, blockNumber, index := rawdb.ReadTransaction(api.chain.database, hash)
+	if tx == nil {
+		return nil, nil
+	}
+
+	return api.marshalTx(tx, blockHash, blockNumber, index)
+}
+
+// GetTransactionReceipt returns receipt of mined transaction
+func (api *ethAPI) GetTransactionReceipt(ctx context.Context, hash common.Hash) (*types.Receipt, error) {
+	return api.chain.Backend.TransactionReceipt(ctx, hash)
+}
+
+// GetTransactionCount returns pending nonce of the account
+func (api *ethAPI) GetTransactionCount(ctx context.Context, address common.Address, number rpc.BlockNumber) (hexutil.Uint64, error) {
+	nonce, err := api.chain.Backend.PendingNonceAt(ctx, address)
+	return hexutil.Uint64(nonce), err
+}
+
+// GetBalance returns balance of the account
+func (api *ethAPI) GetBalance(ctx context.Context, address common.Address, number rpc.BlockNumber) (*hexutil.Big, error) {
+	balance, err := api.chain.Backend.BalanceAt(ctx, address, nil)
+	return (*hexutil.Big)(balance), err
+}
+
+// GetCode returns code of the contract
+func (api *ethAPI) GetCode(ctx context.Context, address common.Address, number rpc.BlockNumber) (hexutil.Bytes, error) {
+	return api.chain.Backend.CodeAt(ctx, address, nil)
+}
+
+// GasPrice returns suggested gas price
+func (api *ethAPI) GasPrice(ctx context.Context) (*hexutil.Big, error) {
+	price, err := api.chain.Backend.SuggestGasPrice(ctx)
+	return (*hexutil.Big)(price), err
+}
+
+// EstimateGas estimates gas of the call
+func (api *ethAPI) EstimateGas(ctx context.Context, args CallArgs) (hexutil.Uint64, error) {
+	gas, err := api.chain.Backend.EstimateGas(ctx, args.toCallMsg())
+	return hexutil.Uint64(gas), err
+}
+
+// Call executes call, contract stubs answer their registered methods
+func (api *ethAPI) Call(ctx context.Context, args CallArgs, number rpc.BlockNumber) (hexutil.Bytes, error) {
+	msg := args.toCallMsg()
+	if msg.To != nil {
+		if contract, ok := api.chain.contract(*msg.To); ok {
+			if result, handled, err := contract.call(msg.Data); handled {
+				return result, err
+			}
+		}
+	}
+
+	return api.chain.Backend.CallContract(ctx, msg, nil)
+}
+
+// GetLogs returns logs matching filter criteria
+func (api *ethAPI) GetLogs(ctx context.Context, criteria filters.FilterCriteria) ([]types.Log, error) {
+	logs, err := api.chain.Backend.FilterLogs(ctx, ethereum.FilterQuery(criteria))
+	if err != nil {
+		return nil, err
+	}
+
+	if logs == nil {
+		logs = make([]types.Log, 0)
+	}
+	return logs, nil
+}
+
+// SendRawTransaction adds signed transaction to the chain and mines it
+func (api *ethAPI) SendRawTransaction(encodedTx hexutil.Bytes) (common.Hash, error) {
+	tx := new(types.Transaction)
+	if err := rlp.DecodeBytes(encodedTx, tx); err != nil {
+		return common.Hash{}, err
+	}
+
+	if err := api.chain.SendTransaction(tx); err != nil {
+		return common.Hash{}, err
+	}
+
+	return tx.Hash(), nil
+}
+
+func (api *ethAPI) marshalBlock(block *types.Block, fullTx bool) (map[string]interface{}, error) {
+	result, err := toJSONMap(block.Header())
+	if err != nil {
+		return nil, err
+	}
+
+	transactions := make([]interface{}, len(block.Transactions()))
+	for i, tx := range block.Transactions() {
+		if !fullTx {
+			transactions[i] = tx.Hash()
+			continue
+		}
+
+		if transactions[i], err = api.marshalTx(tx, block.Hash(), block.NumberU64(), uint64(i)); err != nil {
+			return nil, err
+		}
+	}
+
+	result["transactions"] = transactions
+	result["uncles"] = make([]common.Hash, 0)
+	result["totalDifficulty"] = (*hexutil.Big)(api.chain.Backend.Blockchain().GetTd(block.Hash(), block.NumberU64()))
+	return result, nil
+}
+
+func (api *ethAPI) marshalTx(tx *types.Transaction, blockHash common.Hash, blockNumber uint64, index uint64) (map[string]interface{}, error) {
+	result, err := toJSONMap(tx)
+	if err != nil {
+		return nil, err
+	}
+
+	number := new(big.Int).SetUint64(blockNumber)
+	from, err := types.Sender(api.chain.signer(number), tx)
+	if err != nil {
+		return nil, err
+	}
+
+	result["blockHash"] = blockHash
+	result["blockNumber"] = (*hexutil.Big)(number)
+	result["transactionIndex"] = hexutil.Uint64(index)
+	result["from"] = from
+	return result, nil
+}
+
+// netAPI serves net namespace
+type netAPI struct {
+	chain *SimulatedChain
+}
+
+// Version returns network id
+func (api *netAPI) Version() string {
+	return api.chain.ChainID().String()
+}
+
+//
+// utils
+//
+
+func toJSONMap(v interface{}) (map[string]interface{}, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+
+	var result map[string]interface{}
+	if err := json.Unmarshal(data, &result); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
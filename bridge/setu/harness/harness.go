@@ -0,0 +1,372 @@
+// Package harness runs bridge processors end-to-end against simulated chains.
+//
+// Harness wires a simulated root chain with contract stubs, a fake bor chain serving bor specific
+// json-rpc methods and an in-process heimdall node (app, tendermint rpc and rest server) with a single
+// validator. Bridge processors are started on top of them with in-memory queue, so a test emits an
+// event on root chain, sends the task a listener would send and checks heimdall state after producing blocks.
+package harness
+
+import (
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"math/big"
+	"sync"
+
+	"github.com/RichardKnop/machinery/v1/tasks"
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/maticnetwork/bor/common"
+	"github.com/maticnetwork/bor/core/types"
+	"github.com/spf13/viper"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+	rpcclient "github.com/tendermint/tendermint/rpc/client"
+
+	"github.com/maticnetwork/heimdall/app"
+	authTypes "github.com/maticnetwork/heimdall/auth/types"
+	borTypes "github.com/maticnetwork/heimdall/bor/types"
+	"github.com/maticnetwork/heimdall/bridge/setu/broadcaster"
+	"github.com/maticnetwork/heimdall/bridge/setu/processor"
+	"github.com/maticnetwork/heimdall/bridge/setu/queue"
+	"github.com/maticnetwork/heimdall/bridge/setu/util"
+	chainmanagerTypes "github.com/maticnetwork/heimdall/chainmanager/types"
+	checkpointTypes "github.com/maticnetwork/heimdall/checkpoint/types"
+	"github.com/maticnetwork/heimdall/contracts/erc20"
+	"github.com/maticnetwork/heimdall/contracts/slashmanager"
+	"github.com/maticnetwork/heimdall/contracts/stakemanager"
+	"github.com/maticnetwork/heimdall/contracts/stakinginfo"
+	"github.com/maticnetwork/heimdall/contracts/statereceiver"
+	"github.com/maticnetwork/heimdall/contracts/statesender"
+	"github.com/maticnetwork/heimdall/contracts/validatorset"
+	"github.com/maticnetwork/heimdall/helper"
+	slashingTypes "github.com/maticnetwork/heimdall/slashing/types"
+	stakingTypes "github.com/maticnetwork/heimdall/staking/types"
+	topupTypes "github.com/maticnetwork/heimdall/topup/types"
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+const (
+	// HeimdallChainID is chain id of heimdall node
+	HeimdallChainID = "heimdall-harness"
+
+	// ValidatorID is id of harness validator
+	ValidatorID = 1
+
+	// ValidatorPower is voting power of harness validator
+	ValidatorPower = 10000
+)
+
+// genesisBalance is fee token balance of genesis accounts (1000 tokens)
+var genesisBalance, _ = big.NewInt(0).SetString("1000000000000000000000", 10)
+
+// bridge db is a process-wide singleton, all harnesses share the same one
+var (
+	bridgeDBOnce sync.Once
+	bridgeDBPath string
+)
+
+// Config is harness configuration
+type Config struct {
+	// Accounts are funded on root chain and have fee tokens on heimdall
+	Accounts []common.Address
+
+	// Genesis modifies heimdall genesis state (e.g. to add buffered checkpoint), contract stubs are deployed
+	Genesis func(h *Harness, appState app.GenesisState) (app.GenesisState, error)
+}
+
+// Harness runs bridge processors against simulated root chain, fake bor and in-process heimdall
+type Harness struct {
+	Root     *SimulatedChain
+	Bor      *FakeBor
+	Heimdall *HeimdallNode
+
+	// root chain stubs
+	RootChain    *RootChain
+	StakingInfo  *Contract
+	StakeManager *Contract
+	SlashManager *Contract
+	StateSender  *Contract
+	MaticToken   *Contract
+
+	// bor stubs
+	StateReceiver *Contract
+	ValidatorSet  *Contract
+
+	// validator running the bridge
+	ValidatorKey     secp256k1.PrivKeySecp256k1
+	ValidatorAddress common.Address
+
+	QueueConnector *queue.QueueConnector
+	TxBroadcaster  *broadcaster.TxBroadcaster
+
+	processorService *processor.ProcessorService
+}
+
+// New creates simulated chains, heimdall node and starts bridge processors
+func New(cfg Config) (h *Harness, err error) {
+	h = &Harness{ValidatorKey: secp256k1.GenPrivKey()}
+	h.ValidatorAddress = common.BytesToAddress(h.ValidatorKey.PubKey().Address().Bytes())
+
+	// close whatever is started on error
+	defer func() {
+		if err != nil {
+			h.Close()
+		}
+	}()
+
+	// validator key is used by bridge and side-tx handlers
+	helper.SetTestPrivKey(h.ValidatorKey)
+
+	accounts := append([]common.Address{h.ValidatorAddress}, cfg.Accounts...)
+	if h.Root, err = NewSimulatedChain("root", accounts...); err != nil {
+		return nil, err
+	}
+	if h.Bor, err = NewFakeBor(h.ValidatorAddress); err != nil {
+		return nil, err
+	}
+	helper.SetTestChainClients(h.Root.RPCClient(), h.Bor.RPCClient())
+
+	appState, err := h.genesis(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	conf := helper.GetDefaultHeimdallConfig()
+	conf.QueueBackend = helper.MemoryQueueBackend
+	helper.SetTestConfig(conf)
+
+	if h.Heimdall, err = NewHeimdallNode(HeimdallChainID, h.ValidatorKey, ValidatorPower, appState); err != nil {
+		return nil, err
+	}
+	helper.GenesisDoc.ChainID = HeimdallChainID
+
+	// bridge talks to heimdall through rest server and tendermint rpc
+	conf.HeimdallServerURL = h.Heimdall.RESTURL()
+	conf.TendermintRPCUrl = h.Heimdall.RPCURL()
+	helper.SetTestConfig(conf)
+
+	viper.Set(client.FlagNode, h.Heimdall.RPCURL())
+	viper.Set(client.FlagTrustNode, true)
+	viper.Set(util.BridgeDBFlag, sharedBridgeDBPath())
+	viper.Set("all", true)
+	viper.SetDefault("log_level", "error")
+
+	if err = h.startBridge(); err != nil {
+		return nil, err
+	}
+
+	return h, nil
+}
+
+// SendTask sends task with event log (the way listeners do) and returns once it is processed
+func (h *Harness) SendTask(taskName string, eventName string, vLog *types.Log) error {
+	logBytes, err := json.Marshal(vLog)
+	if err != nil {
+		return err
+	}
+
+	signature := &tasks.Signature{
+		Name: taskName,
+		Args: []tasks.Arg{
+			{
+				Type:  "string",
+				Value: eventName,
+			},
+			{
+				Type:  "string",
+				Value: string(logBytes),
+			},
+		},
+	}
+
+	result, err := h.QueueConnector.Server.SendTask(signature)
+	if err != nil {
+		return err
+	}
+
+	if state := result.GetState(); state.IsFailure() {
+		return errors.New(state.Error)
+	}
+
+	return nil
+}
+
+// ProduceBlocks produces heimdall blocks, side-txs need 2 more blocks to be approved
+func (h *Harness) ProduceBlocks(count int) {
+	h.Heimdall.ProduceBlocks(count)
+}
+
+// Context returns heimdall context at latest height to read state through keepers
+func (h *Harness) Context() sdk.Context {
+	return h.Heimdall.App.NewContext(true, abci.Header{ChainID: HeimdallChainID, Height: h.Heimdall.Height()})
+}
+
+// Close stops processors, heimdall and simulated chains
+func (h *Harness) Close() {
+	if h.processorService != nil {
+		if err := h.processorService.Stop(); err != nil {
+			util.Logger().Error("Error while stopping processors", "error", err)
+		}
+	}
+	if h.Heimdall != nil {
+		h.Heimdall.Close()
+	}
+	if h.Bor != nil {
+		h.Bor.Close()
+	}
+	if h.Root != nil {
+		h.Root.Close()
+	}
+}
+
+//
+// utils
+//
+
+// deployContracts deploys contract stubs on root chain and bor
+func (h *Harness) deployContracts(childBlockInterval uint64) (err error) {
+	if h.RootChain, err = DeployRootChain(h.Root, childBlockInterval); err != nil {
+		return err
+	}
+
+	rootContracts := []struct {
+		contract **Contract
+		name     string
+		abi      string
+	}{
+		{&h.StakingInfo, "StakingInfo", stakinginfo.StakinginfoABI},
+		{&h.StakeManager, "StakeManager", stakemanager.StakemanagerABI},
+		{&h.SlashManager, "SlashManager", slashmanager.SlashmanagerABI},
+		{&h.StateSender, "StateSender", statesender.StatesenderABI},
+		{&h.MaticToken, "MaticToken", erc20.Erc20ABI},
+	}
+	for _, c := range rootContracts {
+		if *c.contract, err = h.Root.DeployContract(c.name, c.abi); err != nil {
+			return err
+		}
+	}
+
+	if h.StateReceiver, err = h.Bor.DeployContract("StateReceiver", statereceiver.StatereceiverABI); err != nil {
+		return err
+	}
+	if h.ValidatorSet, err = h.Bor.DeployContract("ValidatorSet", validatorset.ValidatorsetABI); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// genesis returns heimdall genesis state with harness validator and contract stubs, same as `heimdalld init`
+func (h *Harness) genesis(cfg Config) (app.GenesisState, error) {
+	appState := app.NewDefaultGenesisState()
+
+	checkpointState := checkpointTypes.GetGenesisStateFromAppState(appState)
+	if err := h.deployContracts(checkpointState.Params.ChildBlockInterval); err != nil {
+		return nil, err
+	}
+
+	// validator
+	signer := hmTypes.BytesToHeimdallAddress(h.ValidatorAddress.Bytes())
+	validator := hmTypes.NewValidator(
+		hmTypes.NewValidatorID(ValidatorID),
+		0, 0, 1, ValidatorPower,
+		hmTypes.NewPubKey(helper.GetPubObjects(h.ValidatorKey.PubKey()).Bytes()),
+		signer,
+	)
+	validators := []*hmTypes.Validator{validator}
+	validatorSet := hmTypes.NewValidatorSet(validators)
+
+	valSigningInfo := hmTypes.NewValidatorSigningInfo(validator.ID, 0, 0, 0)
+	valSigningInfoMap := map[string]hmTypes.ValidatorSigningInfo{valSigningInfo.ValID.String(): valSigningInfo}
+
+	// accounts
+	genesisAccounts := []authTypes.GenesisAccount{}
+	for _, address := range append([]common.Address{h.ValidatorAddress}, cfg.Accounts...) {
+		account, err := genesisAccount(address)
+		if err != nil {
+			return nil, err
+		}
+		genesisAccounts = append(genesisAccounts, account)
+	}
+
+	var err error
+	if appState, err = authTypes.SetGenesisStateToAppState(appState, genesisAccounts); err != nil {
+		return nil, err
+	}
+	if appState, err = stakingTypes.SetGenesisStateToAppState(appState, validators, *validatorSet); err != nil {
+		return nil, err
+	}
+	if appState, err = slashingTypes.SetGenesisStateToAppState(appState, valSigningInfoMap); err != nil {
+		return nil, err
+	}
+	if appState, err = borTypes.SetGenesisStateToAppState(appState, *validatorSet); err != nil {
+		return nil, err
+	}
+	if appState, err = topupTypes.SetGenesisStateToAppState(appState, []hmTypes.DividendAccount{hmTypes.NewDividendAccount(signer, "0")}); err != nil {
+		return nil, err
+	}
+
+	// contract stubs, events are accepted without confirmations
+	chainState := chainmanagerTypes.GetGenesisStateFromAppState(appState)
+	chainState.Params.MainchainTxConfirmations = 0
+	chainState.Params.MaticchainTxConfirmations = 0
+	chainState.Params.ChainParams.BorChainID = h.Bor.ChainID().String()
+	chainState.Params.ChainParams.RootChainAddress = hmTypes.BytesToHeimdallAddress(h.RootChain.Address.Bytes())
+	chainState.Params.ChainParams.StakingInfoAddress = hmTypes.BytesToHeimdallAddress(h.StakingInfo.Address.Bytes())
+	chainState.Params.ChainParams.StakingManagerAddress = hmTypes.BytesToHeimdallAddress(h.StakeManager.Address.Bytes())
+	chainState.Params.ChainParams.SlashManagerAddress = hmTypes.BytesToHeimdallAddress(h.SlashManager.Address.Bytes())
+	chainState.Params.ChainParams.StateSenderAddress = hmTypes.BytesToHeimdallAddress(h.StateSender.Address.Bytes())
+	chainState.Params.ChainParams.MaticTokenAddress = hmTypes.BytesToHeimdallAddress(h.MaticToken.Address.Bytes())
+	chainState.Params.ChainParams.StateReceiverAddress = hmTypes.BytesToHeimdallAddress(h.StateReceiver.Address.Bytes())
+	chainState.Params.ChainParams.ValidatorSetAddress = hmTypes.BytesToHeimdallAddress(h.ValidatorSet.Address.Bytes())
+	appState[chainmanagerTypes.ModuleName] = chainmanagerTypes.ModuleCdc.MustMarshalJSON(chainState)
+
+	if cfg.Genesis != nil {
+		return cfg.Genesis(h, appState)
+	}
+
+	return appState, nil
+}
+
+// startBridge creates queue connector, tx broadcaster and starts processors
+func (h *Harness) startBridge() (err error) {
+	if h.QueueConnector, err = queue.NewQueueConnector(helper.MemoryQueueBackend, ""); err != nil {
+		return err
+	}
+
+	cdc := app.MakeCodec()
+	h.TxBroadcaster = broadcaster.NewTxBroadcaster(cdc)
+
+	httpClient := rpcclient.NewHTTP(h.Heimdall.RPCURL(), "/websocket")
+	h.processorService = processor.NewProcessorService(cdc, h.QueueConnector, httpClient, h.TxBroadcaster)
+	if err := h.processorService.Start(); err != nil {
+		return err
+	}
+
+	h.QueueConnector.StartWorker()
+	return nil
+}
+
+// genesisAccount returns genesis account with fee tokens
+func genesisAccount(address common.Address) (authTypes.GenesisAccount, error) {
+	acc := authTypes.NewBaseAccountWithAddress(hmTypes.BytesToHeimdallAddress(address.Bytes()))
+	if err := acc.SetCoins(sdk.Coins{sdk.Coin{Denom: authTypes.FeeToken, Amount: sdk.NewIntFromBigInt(genesisBalance)}}); err != nil {
+		return authTypes.GenesisAccount{}, err
+	}
+
+	return authTypes.NewGenesisAccountI(&acc)
+}
+
+// sharedBridgeDBPath returns path of bridge db shared by all harnesses in the process
+func sharedBridgeDBPath() string {
+	bridgeDBOnce.Do(func() {
+		dir, err := ioutil.TempDir("", "bridge-harness")
+		if err != nil {
+			panic(err)
+		}
+		bridgeDBPath = dir
+	})
+
+	return bridgeDBPath
+}
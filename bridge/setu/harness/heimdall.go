@@ -0,0 +1,214 @@
+package harness
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"net/http/httptest"
+	"sync"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client/lcd"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+	"github.com/tendermint/tendermint/libs/log"
+	rpcclient "github.com/tendermint/tendermint/rpc/client"
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+	tmTypes "github.com/tendermint/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/maticnetwork/heimdall/app"
+	"github.com/maticnetwork/heimdall/server"
+)
+
+// number of empty blocks produced after genesis, side-txs are not processed till height 2
+const heimdallStartHeight = 2
+
+// HeimdallNode is an in-process heimdall app driven by a single validator, with tendermint rpc and rest server.
+//
+// Blocks are produced on demand: txs broadcasted through rpc are collected in mempool and delivered in next block.
+// Side-txs are voted by the validator the way tendermint does, i.e. results of side-txs delivered at height H
+// are passed to begin side-block at height H+2.
+type HeimdallNode struct {
+	App     *app.HeimdallApp
+	ChainID string
+
+	validatorKey   secp256k1.PrivKeySecp256k1
+	validatorPower int64
+
+	mutex         sync.Mutex
+	mempool       []tmTypes.Tx
+	txs           map[string]*ctypes.ResultTx
+	sideTxResults map[int64][]abci.SideTxResult
+	timeOffset    time.Duration
+
+	rpcServer  *httptest.Server
+	restServer *httptest.Server
+}
+
+// NewHeimdallNode creates heimdall app from genesis state and starts rpc and rest servers
+func NewHeimdallNode(chainID string, validatorKey secp256k1.PrivKeySecp256k1, validatorPower int64, appState app.GenesisState) (*HeimdallNode, error) {
+	node := &HeimdallNode{
+		App:            app.NewHeimdallApp(log.NewNopLogger(), dbm.NewMemDB()),
+		ChainID:        chainID,
+		validatorKey:   validatorKey,
+		validatorPower: validatorPower,
+		txs:            make(map[string]*ctypes.ResultTx),
+		sideTxResults:  make(map[int64][]abci.SideTxResult),
+	}
+
+	stateBytes, err := json.Marshal(appState)
+	if err != nil {
+		return nil, err
+	}
+
+	node.App.InitChain(abci.RequestInitChain{
+		Time:          node.blockTime(),
+		ChainId:       chainID,
+		AppStateBytes: stateBytes,
+	})
+
+	// tendermint rpc
+	node.rpcServer = httptest.NewServer(newTendermintMux(node))
+
+	// rest server, same routes as `heimdalld rest-server`
+	rs := lcd.NewRestServer(node.App.Codec())
+	rs.CliCtx.Client = rpcclient.NewHTTP(node.rpcServer.URL, "/websocket")
+	rs.CliCtx.NodeURI = node.rpcServer.URL
+	rs.CliCtx.TrustNode = true
+	server.RegisterRoutes(rs)
+	node.restServer = httptest.NewServer(rs.Mux)
+
+	node.ProduceBlocks(heimdallStartHeight)
+	return node, nil
+}
+
+// RPCURL returns url of tendermint rpc server
+func (n *HeimdallNode) RPCURL() string {
+	return n.rpcServer.URL
+}
+
+// RESTURL returns url of rest server
+func (n *HeimdallNode) RESTURL() string {
+	return n.restServer.URL
+}
+
+// Height returns height of the last committed block
+func (n *HeimdallNode) Height() int64 {
+	return n.App.LastBlockHeight()
+}
+
+// ProduceBlocks produces given number of blocks
+func (n *HeimdallNode) ProduceBlocks(count int) {
+	for i := 0; i < count; i++ {
+		n.ProduceBlock()
+	}
+}
+
+// ProduceBlock delivers txs from mempool in a new block and commits it. Returns height of the block.
+func (n *HeimdallNode) ProduceBlock() int64 {
+	n.mutex.Lock()
+	defer n.mutex.Unlock()
+
+	height := n.App.LastBlockHeight() + 1
+	header := abci.Header{
+		ChainID:         n.ChainID,
+		Height:          height,
+		Time:            n.blockTime(),
+		ProposerAddress: n.validatorKey.PubKey().Address(),
+	}
+
+	validator := abci.Validator{
+		Address: n.validatorKey.PubKey().Address(),
+		Power:   n.validatorPower,
+	}
+
+	n.App.BeginBlock(abci.RequestBeginBlock{
+		Header: header,
+		LastCommitInfo: abci.LastCommitInfo{
+			Votes: []abci.VoteInfo{{Validator: validator, SignedLastBlock: true}},
+		},
+	})
+
+	n.App.BeginSideBlock(abci.RequestBeginSideBlock{
+		Header:        header,
+		SideTxResults: n.sideTxResults[height-2],
+	})
+	delete(n.sideTxResults, height-2)
+
+	txs := n.mempool
+	n.mempool = nil
+
+	for i, tx := range txs {
+		res := n.App.DeliverTx(abci.RequestDeliverTx{Tx: tx})
+		n.txs[hex.EncodeToString(tx.Hash())] = &ctypes.ResultTx{
+			Hash:     tx.Hash(),
+			Height:   height,
+			Index:    uint32(i),
+			TxResult: res,
+			Tx:       tx,
+		}
+
+		if res.Code != abci.CodeTypeOK {
+			continue
+		}
+
+		// validator votes on side-tx
+		sideRes := n.App.DeliverSideTx(abci.RequestDeliverSideTx{Tx: tx})
+		if sideRes.Code == abci.CodeTypeOK && sideRes.Result != abci.SideTxResultType_Skip {
+			n.sideTxResults[height] = append(n.sideTxResults[height], abci.SideTxResult{
+				TxHash: tx.Hash(),
+				Sigs: []abci.SideTxSig{{
+					Result:  sideRes.Result,
+					Address: validator.Address,
+				}},
+			})
+		}
+	}
+
+	n.App.EndBlock(abci.RequestEndBlock{Height: height})
+	n.App.Commit()
+	return height
+}
+
+// AdvanceTime moves time of next blocks
+func (n *HeimdallNode) AdvanceTime(duration time.Duration) {
+	n.mutex.Lock()
+	defer n.mutex.Unlock()
+
+	n.timeOffset += duration
+}
+
+// Tx returns result of delivered tx, nil if tx is not delivered yet
+func (n *HeimdallNode) Tx(hash []byte) *ctypes.ResultTx {
+	n.mutex.Lock()
+	defer n.mutex.Unlock()
+
+	return n.txs[hex.EncodeToString(hash)]
+}
+
+// Close stops rpc and rest servers
+func (n *HeimdallNode) Close() {
+	n.restServer.Close()
+	n.rpcServer.Close()
+}
+
+//
+// utils
+//
+
+// checkTx checks tx and adds it into mempool
+func (n *HeimdallNode) checkTx(tx tmTypes.Tx) abci.ResponseCheckTx {
+	n.mutex.Lock()
+	defer n.mutex.Unlock()
+
+	res := n.App.CheckTx(abci.RequestCheckTx{Tx: tx})
+	if res.Code == abci.CodeTypeOK {
+		n.mempool = append(n.mempool, tx)
+	}
+
+	return res
+}
+
+func (n *HeimdallNode) blockTime() time.Time {
+	return time.Now().UTC().Add(n.timeOffset)
+}
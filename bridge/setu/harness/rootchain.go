@@ -0,0 +1,115 @@
+package harness
+
+import (
+	"math/big"
+	"sync"
+
+	"github.com/maticnetwork/bor/common"
+	"github.com/maticnetwork/bor/core/types"
+
+	"github.com/maticnetwork/heimdall/contracts/rootchain"
+)
+
+// HeaderBlock is a checkpoint submitted on root chain stub
+type HeaderBlock struct {
+	Root      common.Hash
+	Start     uint64
+	End       uint64
+	CreatedAt uint64
+	Proposer  common.Address
+}
+
+// RootChain is root chain contract stub keeping submitted checkpoints
+type RootChain struct {
+	*Contract
+
+	childBlockInterval uint64
+
+	mutex        sync.RWMutex
+	headerBlocks map[uint64]HeaderBlock // by header block id
+	current      uint64                 // next header block id
+}
+
+// DeployRootChain deploys root chain stub on given chain
+func DeployRootChain(chain *SimulatedChain, childBlockInterval uint64) (*RootChain, error) {
+	contract, err := chain.DeployContract("RootChain", rootchain.RootchainABI)
+	if err != nil {
+		return nil, err
+	}
+
+	rc := &RootChain{
+		Contract:           contract,
+		childBlockInterval: childBlockInterval,
+		headerBlocks:       make(map[uint64]HeaderBlock),
+		current:            childBlockInterval,
+	}
+
+	contract.HandleCall("headerBlocks", rc.handleHeaderBlocks)
+	contract.HandleCall("currentHeaderBlock", rc.handleCurrentHeaderBlock)
+	contract.HandleCall("getLastChildBlock", rc.handleGetLastChildBlock)
+	return rc, nil
+}
+
+// SubmitCheckpoint stores checkpoint as next header block and emits NewHeaderBlock event
+func (rc *RootChain) SubmitCheckpoint(proposer common.Address, start uint64, end uint64, root common.Hash) (*types.Log, error) {
+	rc.mutex.Lock()
+	headerBlockID := rc.current
+	rc.headerBlocks[headerBlockID] = HeaderBlock{
+		Root:      root,
+		Start:     start,
+		End:       end,
+		CreatedAt: rc.chain.LatestHeader().Time,
+		Proposer:  proposer,
+	}
+	rc.current += rc.childBlockInterval
+	rc.mutex.Unlock()
+
+	return rc.Emit(
+		"NewHeaderBlock",
+		proposer,
+		new(big.Int).SetUint64(headerBlockID),
+		big.NewInt(0), // reward
+		new(big.Int).SetUint64(start),
+		new(big.Int).SetUint64(end),
+		[32]byte(root),
+	)
+}
+
+// HeaderBlock returns header block by id
+func (rc *RootChain) HeaderBlock(headerBlockID uint64) (HeaderBlock, bool) {
+	rc.mutex.RLock()
+	defer rc.mutex.RUnlock()
+
+	headerBlock, ok := rc.headerBlocks[headerBlockID]
+	return headerBlock, ok
+}
+
+//
+// call handlers
+//
+
+func (rc *RootChain) handleHeaderBlocks(args []interface{}) ([]interface{}, error) {
+	headerBlock, _ := rc.HeaderBlock(args[0].(*big.Int).Uint64())
+	return []interface{}{
+		[32]byte(headerBlock.Root),
+		new(big.Int).SetUint64(headerBlock.Start),
+		new(big.Int).SetUint64(headerBlock.End),
+		new(big.Int).SetUint64(headerBlock.CreatedAt),
+		headerBlock.Proposer,
+	}, nil
+}
+
+func (rc *RootChain) handleCurrentHeaderBlock(args []interface{}) ([]interface{}, error) {
+	rc.mutex.RLock()
+	defer rc.mutex.RUnlock()
+
+	return []interface{}{new(big.Int).SetUint64(rc.current)}, nil
+}
+
+func (rc *RootChain) handleGetLastChildBlock(args []interface{}) ([]interface{}, error) {
+	rc.mutex.RLock()
+	defer rc.mutex.RUnlock()
+
+	headerBlock := rc.headerBlocks[rc.current-rc.childBlockInterval]
+	return []interface{}{new(big.Int).SetUint64(headerBlock.End)}, nil
+}
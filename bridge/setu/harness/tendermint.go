@@ -0,0 +1,114 @@
+package harness
+
+import (
+	"errors"
+	"net/http"
+
+	amino "github.com/tendermint/go-amino"
+	abci "github.com/tendermint/tendermint/abci/types"
+	cmn "github.com/tendermint/tendermint/libs/common"
+	"github.com/tendermint/tendermint/libs/log"
+	"github.com/tendermint/tendermint/p2p"
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+	rpcserver "github.com/tendermint/tendermint/rpc/lib/server"
+	rpctypes "github.com/tendermint/tendermint/rpc/lib/types"
+	tmTypes "github.com/tendermint/tendermint/types"
+)
+
+// tendermintAPI serves subset of tendermint rpc used by bridge, cli and rest server
+type tendermintAPI struct {
+	node *HeimdallNode
+}
+
+// newTendermintMux returns http handler serving tendermint rpc for the node
+func newTendermintMux(node *HeimdallNode) *http.ServeMux {
+	api := &tendermintAPI{node: node}
+	routes := map[string]*rpcserver.RPCFunc{
+		"status":              rpcserver.NewRPCFunc(api.Status, ""),
+		"abci_info":           rpcserver.NewRPCFunc(api.ABCIInfo, ""),
+		"abci_query":          rpcserver.NewRPCFunc(api.ABCIQuery, "path,data,height,prove"),
+		"broadcast_tx_async":  rpcserver.NewRPCFunc(api.BroadcastTxSync, "tx"),
+		"broadcast_tx_sync":   rpcserver.NewRPCFunc(api.BroadcastTxSync, "tx"),
+		"broadcast_tx_commit": rpcserver.NewRPCFunc(api.BroadcastTxCommit, "tx"),
+		"tx":                  rpcserver.NewRPCFunc(api.Tx, "hash,prove"),
+	}
+
+	cdc := amino.NewCodec()
+	ctypes.RegisterAmino(cdc)
+
+	mux := http.NewServeMux()
+	rpcserver.RegisterRPCFuncs(mux, routes, cdc, log.NewNopLogger())
+	return mux
+}
+
+// Status returns node status
+func (api *tendermintAPI) Status(ctx *rpctypes.Context) (*ctypes.ResultStatus, error) {
+	app := api.node.App
+	return &ctypes.ResultStatus{
+		NodeInfo: p2p.DefaultNodeInfo{Network: api.node.ChainID},
+		SyncInfo: ctypes.SyncInfo{
+			LatestAppHash:     app.LastCommitID().Hash,
+			LatestBlockHeight: app.LastBlockHeight(),
+			LatestBlockTime:   api.node.blockTime(),
+		},
+		ValidatorInfo: ctypes.ValidatorInfo{
+			Address:     api.node.validatorKey.PubKey().Address(),
+			PubKey:      api.node.validatorKey.PubKey(),
+			VotingPower: api.node.validatorPower,
+		},
+	}, nil
+}
+
+// ABCIInfo returns app info
+func (api *tendermintAPI) ABCIInfo(ctx *rpctypes.Context) (*ctypes.ResultABCIInfo, error) {
+	return &ctypes.ResultABCIInfo{Response: api.node.App.Info(abci.RequestInfo{})}, nil
+}
+
+// ABCIQuery queries app
+func (api *tendermintAPI) ABCIQuery(ctx *rpctypes.Context, path string, data cmn.HexBytes, height int64, prove bool) (*ctypes.ResultABCIQuery, error) {
+	res := api.node.App.Query(abci.RequestQuery{
+		Path:   path,
+		Data:   data,
+		Height: height,
+		Prove:  prove,
+	})
+	return &ctypes.ResultABCIQuery{Response: res}, nil
+}
+
+// BroadcastTxSync checks tx and adds it into mempool
+func (api *tendermintAPI) BroadcastTxSync(ctx *rpctypes.Context, tx tmTypes.Tx) (*ctypes.ResultBroadcastTx, error) {
+	res := api.node.checkTx(tx)
+	return &ctypes.ResultBroadcastTx{
+		Code: res.Code,
+		Data: res.Data,
+		Log:  res.Log,
+		Hash: tx.Hash(),
+	}, nil
+}
+
+// BroadcastTxCommit checks tx and produces a block with it
+func (api *tendermintAPI) BroadcastTxCommit(ctx *rpctypes.Context, tx tmTypes.Tx) (*ctypes.ResultBroadcastTxCommit, error) {
+	checkRes := api.node.checkTx(tx)
+	if checkRes.Code != abci.CodeTypeOK {
+		return &ctypes.ResultBroadcastTxCommit{CheckTx: checkRes, Hash: tx.Hash()}, nil
+	}
+
+	height := api.node.ProduceBlock()
+	result := api.node.Tx(tx.Hash())
+	return &ctypes.ResultBroadcastTxCommit{
+		CheckTx:   checkRes,
+		DeliverTx: result.TxResult,
+		Hash:      tx.Hash(),
+		Height:    height,
+	}, nil
+}
+
+// Tx returns delivered tx by hash
+func (api *tendermintAPI) Tx(ctx *rpctypes.Context, hash []byte, prove bool) (*ctypes.ResultTx, error) {
+	result := api.node.Tx(hash)
+	if result == nil {
+		return nil, errors.New("Tx not found")
+	}
+
+	return result, nil
+}
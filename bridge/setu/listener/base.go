@@ -2,6 +2,7 @@ package listener
 
 import (
 	"context"
+	"fmt"
 	"time"
 
 	"github.com/cosmos/cosmos-sdk/client"
@@ -71,6 +72,13 @@ type BaseListener struct {
 
 	// storage client
 	storageClient *leveldb.DB
+
+	// replay options (event filter and dry run)
+	eventFilter map[string]bool
+	dryRun      bool
+
+	// failures reported to supervisor
+	failed chan error
 }
 
 // NewBaseListener creates a new BaseListener.
@@ -102,6 +110,7 @@ func NewBaseListener(cdc *codec.Codec, queueConnector *queue.QueueConnector, htt
 		chainClient:       chainClient,
 
 		HeaderChannel: make(chan *types.Header),
+		failed:        make(chan error, 1),
 	}
 }
 
@@ -192,6 +201,9 @@ func (bl *BaseListener) StartSubscription(ctx context.Context, subscription ethe
 			if bl.cancelSubscription != nil {
 				bl.cancelSubscription()
 			}
+
+			// listener is restarted by supervisor
+			bl.fail(fmt.Errorf("subscription failed: %v", err))
 			return
 		case <-ctx.Done():
 			bl.Logger.Info("Subscription stopped")
@@ -200,6 +212,19 @@ func (bl *BaseListener) StartSubscription(ctx context.Context, subscription ethe
 	}
 }
 
+// Failed returns channel on which listener reports failures after it is started
+func (bl *BaseListener) Failed() <-chan error {
+	return bl.failed
+}
+
+// fail reports failure, only the first one is kept till it is received
+func (bl *BaseListener) fail(err error) {
+	select {
+	case bl.failed <- err:
+	default:
+	}
+}
+
 // OnStop stops all necessary go routines
 func (bl *BaseListener) Stop() {
 
@@ -209,5 +234,7 @@ func (bl *BaseListener) Stop() {
 	}
 
 	// cancel header process
-	bl.cancelHeaderProcess()
+	if bl.cancelHeaderProcess != nil {
+		bl.cancelHeaderProcess()
+	}
 }
@@ -0,0 +1,53 @@
+package listener
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/syndtr/goleveldb/leveldb"
+)
+
+// cursorKeys maps listener name to storage key of its last processed block
+var cursorKeys = map[string]string{
+	RootChainListenerStr: lastRootBlockKey,
+	HeimdallListenerStr:  heimdallLastBlockKey,
+}
+
+// CursorListeners returns names of listeners which persist a block cursor
+func CursorListeners() []string {
+	return []string{RootChainListenerStr, HeimdallListenerStr}
+}
+
+// GetListenerCursor returns last processed block of given listener.
+// Returns false if listener has not stored any block yet.
+func GetListenerCursor(storageClient *leveldb.DB, name string) (uint64, bool, error) {
+	key, ok := cursorKeys[name]
+	if !ok {
+		return 0, false, fmt.Errorf("Listener %v does not have a cursor", name)
+	}
+
+	lastBlockBytes, err := storageClient.Get([]byte(key), nil)
+	if err == leveldb.ErrNotFound {
+		return 0, false, nil
+	} else if err != nil {
+		return 0, false, err
+	}
+
+	lastBlock, err := strconv.ParseUint(string(lastBlockBytes), 10, 64)
+	if err != nil {
+		return 0, false, err
+	}
+
+	return lastBlock, true, nil
+}
+
+// SetListenerCursor forces last processed block of given listener.
+// Listener resumes from block+1 on its next header/poll.
+func SetListenerCursor(storageClient *leveldb.DB, name string, block uint64) error {
+	key, ok := cursorKeys[name]
+	if !ok {
+		return fmt.Errorf("Listener %v does not have a cursor", name)
+	}
+
+	return storageClient.Put([]byte(key), []byte(strconv.FormatUint(block, 10)), nil)
+}
@@ -7,19 +7,36 @@ import (
 	"time"
 
 	"github.com/RichardKnop/machinery/v1/tasks"
+	"github.com/maticnetwork/bor/common"
 	"github.com/maticnetwork/bor/core/types"
+	"github.com/maticnetwork/heimdall/bridge/setu/metrics"
+	"github.com/maticnetwork/heimdall/bridge/setu/queue"
+	"github.com/maticnetwork/heimdall/bridge/setu/util"
 	"github.com/maticnetwork/heimdall/helper"
+	abci "github.com/tendermint/tendermint/abci/types"
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+	tmTypes "github.com/tendermint/tendermint/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	checkpointTypes "github.com/maticnetwork/heimdall/checkpoint/types"
 	slashingTypes "github.com/maticnetwork/heimdall/slashing/types"
+	hmTypes "github.com/maticnetwork/heimdall/types"
 )
 
 const (
 	heimdallLastBlockKey = "heimdall-last-block" // storage key
+
+	heimdallBlockSubscriber = "bridge-heimdall-listener"
+	heimdallBlockCapacity   = 100 // buffered new block events
 )
 
+// rootchainEventMsg is implemented by heimdall msgs which are created from rootchain events
+type rootchainEventMsg interface {
+	GetTxHash() hmTypes.HeimdallHash
+	GetLogIndex() uint64
+}
+
 // HeimdallListener - Listens to and process events from heimdall
 type HeimdallListener struct {
 	BaseListener
@@ -44,8 +61,8 @@ func (hl *HeimdallListener) Start() error {
 		pollInterval = helper.GetConfig().CheckpointerPollInterval
 	}
 
-	hl.Logger.Info("Start polling for events", "pollInterval", pollInterval)
-	hl.StartPolling(headerCtx, pollInterval)
+	hl.Logger.Info("Start subscription for new blocks", "backfillInterval", pollInterval)
+	go hl.StartBlockSubscription(headerCtx, pollInterval)
 	return nil
 }
 
@@ -63,114 +80,297 @@ func (hl *HeimdallListener) StartPolling(ctx context.Context, pollInterval time.
 	// the ending of the interval
 	ticker := time.NewTicker(interval)
 
-	// var eventTypes []string
-	// eventTypes = append(eventTypes, "message.action='checkpoint'")
-	// eventTypes = append(eventTypes, "message.action='event-record'")
-	// eventTypes = append(eventTypes, "message.action='tick'")
-	// ADD EVENT TYPE for SLASH-LIMIT
-
 	// start listening
 	for {
 		select {
 		case <-ticker.C:
-			fromBlock, toBlock, err := hl.fetchFromAndToBlock()
-			if err != nil {
-				hl.Logger.Error("Error fetching fromBlock and toBlock...skipping events query", "error", err)
-			} else if fromBlock < toBlock {
+			hl.backfillBlocks()
 
-				hl.Logger.Info("Fetching new events between", "fromBlock", fromBlock, "toBlock", toBlock)
+		case <-ctx.Done():
+			hl.Logger.Info("Polling stopped")
+			ticker.Stop()
+			return
+		}
+	}
+}
 
-				// Querying and processing Begin events
-				for i := fromBlock; i <= toBlock; i++ {
-					events, err := helper.GetBeginBlockEvents(hl.httpClient, int64(i))
-					if err != nil {
-						hl.Logger.Error("Error fetching begin block events", "error", err)
-					}
-					for _, event := range events {
-						hl.ProcessBlockEvent(sdk.StringifyEvent(event), int64(i))
-					}
-				}
+// StartBlockSubscription subscribes to new heimdall blocks and processes their begin block events.
+// Blocks missed by the subscription (bridge restart, websocket reconnect, full event channel)
+// are backfilled by polling every pollInterval. Both paths run in this go routine,
+// so every block is processed exactly once and heimdallLastBlockKey only moves forward.
+func (hl *HeimdallListener) StartBlockSubscription(ctx context.Context, pollInterval time.Duration) {
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
 
-				// Querying and processing tx Events. Below for loop is kept for future purpose to process events from tx
-				/* 		for _, eventType := range eventTypes {
-					var query []string
-					query = append(query, eventType)
-					query = append(query, fmt.Sprintf("tx.height>=%v", fromBlock))
-					query = append(query, fmt.Sprintf("tx.height<=%v", toBlock))
-
-					limit := 50
-					for page := 1; page > 0; {
-						searchResult, err := helper.QueryTxsByEvents(hl.cliCtx, query, page, limit)
-						hl.Logger.Debug("Fetching new events using search query", "query", query, "page", page, "limit", limit)
-
-						if err != nil {
-							hl.Logger.Error("Error while searching events", "eventType", eventType, "error", err)
-							break
-						}
-
-						for _, tx := range searchResult.Txs {
-							for _, log := range tx.Logs {
-								event := helper.FilterEvents(log.Events, func(et sdk.StringEvent) bool {
-									return et.Type == checkpointTypes.EventTypeCheckpoint || et.Type == clerkTypes.EventTypeRecord
-								})
-								if event != nil {
-									hl.ProcessEvent(*event, tx)
-								}
-							}
-						}
-
-						if len(searchResult.Txs) == limit {
-							page = page + 1
-						} else {
-							page = 0
-						}
-					}
-				} */
-				// set last block to storage
-				if err := hl.storageClient.Put([]byte(heimdallLastBlockKey), []byte(strconv.FormatUint(toBlock, 10)), nil); err != nil {
-					hl.Logger.Error("hl.storageClient.Put", "Error", err)
+	// backfill blocks produced while bridge was stopped
+	hl.backfillBlocks()
+
+	eventCh, err := hl.subscribeNewBlock(ctx)
+	if err != nil {
+		hl.Logger.Error("Error while subscribing to new heimdall blocks, falling back to polling", "error", err)
+	}
+	lastEventAt := time.Now()
+
+	for {
+		select {
+		case event := <-eventCh:
+			lastEventAt = time.Now()
+			if data, ok := event.Data.(tmTypes.EventDataNewBlock); ok && data.Block != nil {
+				hl.processNewBlock(data)
+			}
+
+		case <-ticker.C:
+			// backfill blocks missed by subscription
+			backfilled := hl.backfillBlocks()
+
+			// subscription is gone if blocks are produced but no event arrived
+			if eventCh == nil || (backfilled > 0 && time.Since(lastEventAt) > pollInterval) {
+				hl.Logger.Info("No new block events received, resubscribing", "backfilledBlocks", backfilled)
+				hl.unsubscribeNewBlock()
+				if eventCh, err = hl.subscribeNewBlock(ctx); err != nil {
+					hl.Logger.Error("Error while subscribing to new heimdall blocks", "error", err)
 				}
+				lastEventAt = time.Now()
 			}
 
 		case <-ctx.Done():
-			hl.Logger.Info("Polling stopped")
-			ticker.Stop()
+			hl.Logger.Info("Subscription stopped")
+			hl.unsubscribeNewBlock()
 			return
 		}
 	}
 }
 
-func (hl *HeimdallListener) fetchFromAndToBlock() (uint64, uint64, error) {
-	// toBlock - get latest blockheight from heimdall node
-	fromBlock := uint64(0)
-	toBlock := uint64(0)
+// processNewBlock processes begin block events of a block received through subscription.
+// Blocks between the cursor and the new block are fetched first.
+func (hl *HeimdallListener) processNewBlock(data tmTypes.EventDataNewBlock) {
+	height := uint64(data.Block.Height)
+
+	lastBlock, err := hl.getLastBlock()
+	if err != nil {
+		hl.Logger.Error("Error while fetching last block from storage", "error", err)
+		return
+	}
+
+	// already processed
+	if height <= lastBlock {
+		hl.Logger.Debug("Skipping processed heimdall block", "blockHeight", height, "lastBlock", lastBlock)
+		return
+	}
+
+	// fill the gap first, to keep cursor consistent
+	if height > lastBlock+1 && !hl.processBlocks(lastBlock+1, height-1) {
+		return
+	}
+
+	hl.processBlockEvents(height, data.ResultBeginBlock.GetEvents())
+	metrics.BlockLag.WithLabelValues(HeimdallListenerStr).Set(0)
+}
+
+// backfillBlocks processes all blocks between the cursor and latest heimdall block.
+// Returns number of processed blocks.
+func (hl *HeimdallListener) backfillBlocks() uint64 {
+	lastBlock, err := hl.getLastBlock()
+	if err != nil {
+		hl.Logger.Error("Error while fetching last block from storage", "error", err)
+		return 0
+	}
 
+	// toBlock - get latest blockheight from heimdall node
 	nodeStatus, err := helper.GetNodeStatus(hl.cliCtx)
 	if err != nil {
 		hl.Logger.Error("Error while fetching heimdall node status", "error", err)
-		return fromBlock, toBlock, err
+		return 0
 	}
-	toBlock = uint64(nodeStatus.SyncInfo.LatestBlockHeight)
+	toBlock := uint64(nodeStatus.SyncInfo.LatestBlockHeight)
 
-	// fromBlock - get last block from storage
-	hasLastBlock, _ := hl.storageClient.Has([]byte(heimdallLastBlockKey), nil)
-	if hasLastBlock {
-		lastBlockBytes, err := hl.storageClient.Get([]byte(heimdallLastBlockKey), nil)
+	if toBlock <= lastBlock {
+		metrics.BlockLag.WithLabelValues(HeimdallListenerStr).Set(0)
+		return 0
+	}
+
+	fromBlock := lastBlock + 1
+	metrics.BlockLag.WithLabelValues(HeimdallListenerStr).Set(float64(toBlock - lastBlock))
+	hl.Logger.Info("Fetching new events between", "fromBlock", fromBlock, "toBlock", toBlock)
+
+	hl.processBlocks(fromBlock, toBlock)
+
+	// Querying and processing tx Events. Below for loop is kept for future purpose to process events from tx
+	/* 		for _, eventType := range eventTypes {
+		var query []string
+		query = append(query, eventType)
+		query = append(query, fmt.Sprintf("tx.height>=%v", fromBlock))
+		query = append(query, fmt.Sprintf("tx.height<=%v", toBlock))
+
+		limit := 50
+		for page := 1; page > 0; {
+			searchResult, err := helper.QueryTxsByEvents(hl.cliCtx, query, page, limit)
+			hl.Logger.Debug("Fetching new events using search query", "query", query, "page", page, "limit", limit)
+
+			if err != nil {
+				hl.Logger.Error("Error while searching events", "eventType", eventType, "error", err)
+				break
+			}
+
+			for _, tx := range searchResult.Txs {
+				for _, log := range tx.Logs {
+					event := helper.FilterEvents(log.Events, func(et sdk.StringEvent) bool {
+						return et.Type == checkpointTypes.EventTypeCheckpoint || et.Type == clerkTypes.EventTypeRecord
+					})
+					if event != nil {
+						hl.ProcessEvent(*event, tx)
+					}
+				}
+			}
+
+			if len(searchResult.Txs) == limit {
+				page = page + 1
+			} else {
+				page = 0
+			}
+		}
+	} */
+
+	processed, err := hl.getLastBlock()
+	if err != nil || processed < lastBlock {
+		return 0
+	}
+	return processed - lastBlock
+}
+
+// processBlocks fetches and processes begin block events from fromBlock to toBlock (inclusive).
+// Stops at first block which can't be fetched, so it is retried later instead of being skipped.
+func (hl *HeimdallListener) processBlocks(fromBlock uint64, toBlock uint64) bool {
+	for i := fromBlock; i <= toBlock; i++ {
+		events, err := helper.GetBeginBlockEvents(hl.httpClient, int64(i))
 		if err != nil {
-			hl.Logger.Info("Error while fetching last block bytes from storage", "error", err)
-			return fromBlock, toBlock, err
+			hl.Logger.Error("Error fetching begin block events", "blockHeight", i, "error", err)
+			return false
 		}
 
-		if result, err := strconv.ParseUint(string(lastBlockBytes), 10, 64); err == nil {
-			hl.Logger.Debug("Got last block from bridge storage", "lastBlock", result)
-			fromBlock = uint64(result) + 1
-		} else {
-			hl.Logger.Info("Error parsing last block bytes from storage", "error", err)
-			toBlock = 0
-			return fromBlock, toBlock, err
+		if !hl.processBlockEvents(i, events) {
+			return false
 		}
 	}
-	return fromBlock, toBlock, err
+
+	return true
+}
+
+// processBlockEvents records processed rootchain events, processes begin block events of a block and moves cursor to it.
+// Returns false if block has to be processed again.
+func (hl *HeimdallListener) processBlockEvents(height uint64, events []abci.Event) bool {
+	// idempotency store is an optimisation, processors query heimdall for events missing in it
+	hl.recordProcessedEvents(height, events)
+
+	for _, event := range events {
+		hl.ProcessBlockEvent(sdk.StringifyEvent(event), int64(height))
+	}
+
+	// set last block to storage
+	if err := hl.storageClient.Put([]byte(heimdallLastBlockKey), []byte(strconv.FormatUint(height, 10)), nil); err != nil {
+		hl.Logger.Error("hl.storageClient.Put", "Error", err)
+	}
+
+	return true
+}
+
+// recordProcessedEvents records rootchain events approved in the block to idempotency store.
+// Side-tx post handlers emit heimdall tx hash and side-tx result, rootchain tx hash and log index
+// are taken from the msgs of the heimdall tx. Recording is best-effort, failures are logged and skipped.
+func (hl *HeimdallListener) recordProcessedEvents(height uint64, events []abci.Event) {
+	store := util.NewIdempotencyStore(hl.storageClient)
+	txDecoder := helper.GetTxDecoder(hl.cliCtx.Codec)
+
+	for _, txHash := range getApprovedSideTxHashes(events) {
+		result, err := hl.httpClient.Tx(common.FromHex(txHash), false)
+		if err != nil {
+			hl.Logger.Error("Error fetching side-tx to record processed events", "blockHeight", height, "txHash", txHash, "error", err)
+			continue
+		}
+
+		tx, err := txDecoder(result.Tx)
+		if err != nil {
+			hl.Logger.Error("Error decoding side-tx to record processed events", "blockHeight", height, "txHash", txHash, "error", err)
+			continue
+		}
+
+		if err := markRootchainEvents(store, tx.GetMsgs(), txHash); err != nil {
+			hl.Logger.Error("Error recording processed events", "blockHeight", height, "txHash", txHash, "error", err)
+		}
+	}
+}
+
+// getApprovedSideTxHashes returns unique heimdall tx hashes of approved side-txs in block events
+func getApprovedSideTxHashes(events []abci.Event) []string {
+	var txHashes []string
+	seen := make(map[string]bool)
+
+	for _, event := range events {
+		var txHash, sideTxResult string
+		for _, attr := range sdk.StringifyEvent(event).Attributes {
+			switch attr.Key {
+			case hmTypes.AttributeKeyTxHash:
+				txHash = attr.Value
+			case hmTypes.AttributeKeySideTxResult:
+				sideTxResult = attr.Value
+			}
+		}
+
+		if txHash == "" || sideTxResult != abci.SideTxResultType_Yes.String() || seen[txHash] {
+			continue
+		}
+
+		seen[txHash] = true
+		txHashes = append(txHashes, txHash)
+	}
+
+	return txHashes
+}
+
+// markRootchainEvents records msgs created from rootchain events as processed by heimdall tx
+func markRootchainEvents(store *util.IdempotencyStore, msgs []sdk.Msg, heimdallTxHash string) error {
+	for _, msg := range msgs {
+		if rootchainMsg, ok := msg.(rootchainEventMsg); ok {
+			if err := store.MarkRootchainEvent(rootchainMsg.GetTxHash().Hex(), rootchainMsg.GetLogIndex(), heimdallTxHash); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// getLastBlock returns last processed block from storage (0 if nothing is processed yet)
+func (hl *HeimdallListener) getLastBlock() (uint64, error) {
+	hasLastBlock, _ := hl.storageClient.Has([]byte(heimdallLastBlockKey), nil)
+	if !hasLastBlock {
+		return 0, nil
+	}
+
+	lastBlockBytes, err := hl.storageClient.Get([]byte(heimdallLastBlockKey), nil)
+	if err != nil {
+		return 0, err
+	}
+
+	result, err := strconv.ParseUint(string(lastBlockBytes), 10, 64)
+	if err != nil {
+		return 0, err
+	}
+
+	hl.Logger.Debug("Got last block from bridge storage", "lastBlock", result)
+	return result, nil
+}
+
+func (hl *HeimdallListener) subscribeNewBlock(ctx context.Context) (<-chan ctypes.ResultEvent, error) {
+	query := tmTypes.QueryForEvent(tmTypes.EventNewBlock).String()
+	return hl.httpClient.Subscribe(ctx, heimdallBlockSubscriber, query, heimdallBlockCapacity)
+}
+
+func (hl *HeimdallListener) unsubscribeNewBlock() {
+	query := tmTypes.QueryForEvent(tmTypes.EventNewBlock).String()
+	if err := hl.httpClient.Unsubscribe(context.Background(), heimdallBlockSubscriber, query); err != nil {
+		hl.Logger.Debug("Error while unsubscribing new heimdall blocks", "error", err)
+	}
 }
 
 // ProcessBlockEvent - process Blockevents (BeginBlock, EndBlock events) from heimdall.
@@ -182,6 +382,12 @@ func (hl *HeimdallListener) ProcessBlockEvent(event sdk.StringEvent, blockHeight
 		return
 	}
 
+	if !hl.isEventSelected(event.Type) {
+		return
+	}
+
+	metrics.EventsSeen.WithLabelValues(HeimdallListenerStr, event.Type).Inc()
+
 	switch event.Type {
 	case checkpointTypes.EventTypeCheckpoint:
 		hl.sendBlockTask("sendCheckpointToRootchain", eventBytes, blockHeight)
@@ -209,7 +415,13 @@ func (hl *HeimdallListener) sendBlockTask(taskName string, eventBytes []byte, bl
 			},
 		},
 	}
-	signature.RetryCount = 3
+	signature.RetryCount = queue.DefaultRetryCount
+
+	if hl.dryRun {
+		hl.Logger.Info("Dry run, skip sending block level task", "taskName", taskName, "blockHeight", blockHeight, "event", string(eventBytes))
+		return
+	}
+
 	hl.Logger.Info("Sending block level task", "taskName", taskName, "currentTime", time.Now(), "blockHeight", blockHeight)
 	// send task
 	_, err := hl.queueConnector.Server.SendTask(signature)
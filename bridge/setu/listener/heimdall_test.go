@@ -0,0 +1,74 @@
+package listener
+
+import (
+	"strconv"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"github.com/syndtr/goleveldb/leveldb"
+	"github.com/syndtr/goleveldb/leveldb/storage"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/maticnetwork/heimdall/bridge/setu/util"
+	clerkTypes "github.com/maticnetwork/heimdall/clerk/types"
+	topupTypes "github.com/maticnetwork/heimdall/topup/types"
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+func TestRecordClerkAndTopupEvents(t *testing.T) {
+	db, err := leveldb.Open(storage.NewMemStorage(), nil)
+	require.NoError(t, err)
+	store := util.NewIdempotencyStore(db)
+
+	clerkTxHash := "0x01"
+	topupTxHash := "0x02"
+	rejectedTxHash := "0x03"
+
+	// clerk emits record-tx-log-index and topup emits no log index at all
+	events := sdk.Events{
+		sdk.NewEvent(
+			clerkTypes.EventTypeRecord,
+			sdk.NewAttribute(hmTypes.AttributeKeyTxHash, clerkTxHash),
+			sdk.NewAttribute(clerkTypes.AttributeKeyRecordTxLogIndex, strconv.FormatUint(3, 10)),
+			sdk.NewAttribute(hmTypes.AttributeKeySideTxResult, abci.SideTxResultType_Yes.String()),
+		),
+		sdk.NewEvent(
+			topupTypes.EventTypeTopup,
+			sdk.NewAttribute(hmTypes.AttributeKeyTxHash, topupTxHash),
+			sdk.NewAttribute(hmTypes.AttributeKeySideTxResult, abci.SideTxResultType_Yes.String()),
+		),
+		sdk.NewEvent(
+			topupTypes.EventTypeTransfer,
+			sdk.NewAttribute(hmTypes.AttributeKeyTxHash, topupTxHash),
+			sdk.NewAttribute(hmTypes.AttributeKeySideTxResult, abci.SideTxResultType_Yes.String()),
+		),
+		sdk.NewEvent(
+			topupTypes.EventTypeTopup,
+			sdk.NewAttribute(hmTypes.AttributeKeyTxHash, rejectedTxHash),
+			sdk.NewAttribute(hmTypes.AttributeKeySideTxResult, abci.SideTxResultType_No.String()),
+		),
+	}
+
+	var abciEvents []abci.Event
+	for _, event := range events {
+		abciEvents = append(abciEvents, abci.Event(event))
+	}
+	require.Equal(t, []string{clerkTxHash, topupTxHash}, getApprovedSideTxHashes(abciEvents))
+
+	clerkMsg := clerkTypes.NewMsgEventRecord(hmTypes.HeimdallAddress{}, hmTypes.HexToHeimdallHash("0xaa"), 3, 100, 1, hmTypes.HeimdallAddress{}, nil, "")
+	require.NoError(t, markRootchainEvents(store, []sdk.Msg{clerkMsg}, clerkTxHash))
+
+	topupMsg := topupTypes.NewMsgTopup(hmTypes.HeimdallAddress{}, hmTypes.HeimdallAddress{}, sdk.NewInt(1), hmTypes.HexToHeimdallHash("0xbb"), 7, 100)
+	require.NoError(t, markRootchainEvents(store, []sdk.Msg{topupMsg}, topupTxHash))
+
+	heimdallTxHash, ok, err := store.GetRootchainEvent(hmTypes.HexToHeimdallHash("0xaa").Hex(), 3)
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Equal(t, clerkTxHash, heimdallTxHash)
+
+	heimdallTxHash, ok, err = store.GetRootchainEvent(hmTypes.HexToHeimdallHash("0xbb").Hex(), 7)
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Equal(t, topupTxHash, heimdallTxHash)
+}
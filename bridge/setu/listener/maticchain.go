@@ -6,6 +6,7 @@ import (
 
 	"github.com/RichardKnop/machinery/v1/tasks"
 	"github.com/maticnetwork/bor/core/types"
+	"github.com/maticnetwork/heimdall/bridge/setu/queue"
 	"github.com/maticnetwork/heimdall/helper"
 )
 
@@ -75,7 +76,7 @@ func (ml *MaticChainListener) sendTaskWithDelay(taskName string, headerBytes []b
 			},
 		},
 	}
-	signature.RetryCount = 3
+	signature.RetryCount = queue.DefaultRetryCount
 
 	// add delay for task so that multiple validators won't send same transaction at same time
 	eta := time.Now().Add(delay)
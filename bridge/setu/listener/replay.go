@@ -0,0 +1,75 @@
+package listener
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	httpClient "github.com/tendermint/tendermint/rpc/client"
+
+	"github.com/maticnetwork/heimdall/bridge/setu/queue"
+	"github.com/maticnetwork/heimdall/helper"
+)
+
+// ReplayOptions represents options to replay events of a block range
+type ReplayOptions struct {
+	// event names (rootchain) or event types (heimdall) to replay, all events if empty
+	Events []string
+	// log tasks instead of sending them to queue
+	DryRun bool
+}
+
+// ReplayRootChain replays rootchain events between fromBlock and toBlock (inclusive).
+// Stored listener cursor is not moved.
+func ReplayRootChain(cdc *codec.Codec, queueConnector *queue.QueueConnector, httpClient *httpClient.HTTP, fromBlock uint64, toBlock uint64, options ReplayOptions) error {
+	rootchainListener := NewRootChainListener()
+	rootchainListener.BaseListener = *NewBaseListener(cdc, queueConnector, httpClient, helper.GetMainClient(), RootChainListenerStr, rootchainListener)
+	rootchainListener.setReplayOptions(options)
+
+	rootchainContext, err := rootchainListener.getRootChainContext()
+	if err != nil {
+		return err
+	}
+
+	rootchainListener.Logger.Info("Replaying rootchain events", "fromBlock", fromBlock, "toBlock", toBlock, "events", options.Events, "dryRun", options.DryRun)
+	return rootchainListener.queryAndBroadcastWindows(rootchainContext, fromBlock, toBlock, nil)
+}
+
+// ReplayHeimdall replays heimdall begin block events between fromBlock and toBlock (inclusive).
+// Stored listener cursor is not moved.
+func ReplayHeimdall(cdc *codec.Codec, queueConnector *queue.QueueConnector, httpClient *httpClient.HTTP, fromBlock uint64, toBlock uint64, options ReplayOptions) error {
+	heimdallListener := NewHeimdallListener()
+	heimdallListener.BaseListener = *NewBaseListener(cdc, queueConnector, httpClient, nil, HeimdallListenerStr, heimdallListener)
+	heimdallListener.setReplayOptions(options)
+
+	heimdallListener.Logger.Info("Replaying heimdall events", "fromBlock", fromBlock, "toBlock", toBlock, "events", options.Events, "dryRun", options.DryRun)
+	for i := fromBlock; i <= toBlock; i++ {
+		events, err := helper.GetBeginBlockEvents(httpClient, int64(i))
+		if err != nil {
+			return fmt.Errorf("Error fetching begin block events for block %v: %v", i, err)
+		}
+
+		for _, event := range events {
+			heimdallListener.ProcessBlockEvent(sdk.StringifyEvent(event), int64(i))
+		}
+	}
+
+	return nil
+}
+
+// setReplayOptions sets event filter and dry run mode of the listener
+func (bl *BaseListener) setReplayOptions(options ReplayOptions) {
+	if len(options.Events) > 0 {
+		bl.eventFilter = make(map[string]bool, len(options.Events))
+		for _, name := range options.Events {
+			bl.eventFilter[name] = true
+		}
+	}
+
+	bl.dryRun = options.DryRun
+}
+
+// isEventSelected returns false if event is filtered out while replaying
+func (bl *BaseListener) isEventSelected(name string) bool {
+	return bl.eventFilter == nil || bl.eventFilter[name]
+}
@@ -3,9 +3,13 @@ package listener
 import (
 	"bytes"
 	"context"
+	"encoding/binary"
 	"encoding/json"
+	"errors"
 	"math/big"
 	"strconv"
+	"strings"
+	"sync"
 	"time"
 
 	"github.com/RichardKnop/machinery/v1/tasks"
@@ -13,10 +17,14 @@ import (
 	"github.com/maticnetwork/bor/accounts/abi"
 	ethCommon "github.com/maticnetwork/bor/common"
 	"github.com/maticnetwork/bor/core/types"
+	"github.com/maticnetwork/heimdall/bridge/setu/metrics"
+	"github.com/maticnetwork/heimdall/bridge/setu/queue"
 	"github.com/maticnetwork/heimdall/bridge/setu/util"
 	chainmanagerTypes "github.com/maticnetwork/heimdall/chainmanager/types"
 	"github.com/maticnetwork/heimdall/contracts/stakinginfo"
 	"github.com/maticnetwork/heimdall/helper"
+	hmTypes "github.com/maticnetwork/heimdall/types"
+	levelUtil "github.com/syndtr/goleveldb/leveldb/util"
 )
 
 // RootChainListenerContext root chain listener context
@@ -31,12 +39,23 @@ type RootChainListener struct {
 	abis []*abi.ABI
 
 	stakingInfoAbi *abi.ABI
+
+	// log filter rate limit
+	logsRateMutex   sync.Mutex
+	lastLogsRequest time.Time
 }
 
 const (
-	lastRootBlockKey = "rootchain-last-block" // storage key
+	lastRootBlockKey       = "rootchain-last-block"  // storage key
+	rootBlockHashKeyPrefix = "rootchain-block-hash-" // storage key prefix for processed block hashes
+	rootLogBlockKeyPrefix  = "rootchain-log-block-"  // storage key prefix for hashes of blocks with logs, checked on next pass
+
+	// maxRootReorgDepth is the number of processed blocks whose hashes are tracked
+	maxRootReorgDepth = uint64(256)
 )
 
+var errRootchainReorged = errors.New("rootchain reorged while querying logs")
+
 // NewRootChainListener - constructor func
 func NewRootChainListener() *RootChainListener {
 	contractCaller, err := helper.NewContractCaller()
@@ -124,6 +143,19 @@ func (rl *RootChainListener) ProcessHeader(newHeader *types.Header) {
 			if result >= newHeader.Number.Uint64() {
 				return
 			}
+
+			metrics.BlockLag.WithLabelValues(RootChainListenerStr).Set(float64(newHeader.Number.Uint64() - result))
+
+			// rewind to common ancestor if processed blocks are not canonical anymore
+			if result < latestNumber.Uint64() {
+				ancestor, err := rl.checkReorg(result)
+				if err != nil {
+					rl.Logger.Error("Error while checking rootchain reorg", "lastBlock", result, "error", err)
+					return
+				}
+				result = ancestor
+			}
+
 			fromBlock = big.NewInt(0).SetUint64(result + 1)
 		}
 	}
@@ -135,16 +167,290 @@ func (rl *RootChainListener) ProcessHeader(newHeader *types.Header) {
 		fromBlock = toBlock
 	}
 
-	// set last block to storage
-	if err := rl.storageClient.Put([]byte(lastRootBlockKey), []byte(toBlock.String()), nil); err != nil {
+	// query events window by window, cursor is moved only after a window is fully enqueued
+	if err := rl.queryAndBroadcastWindows(rootchainContext, fromBlock.Uint64(), toBlock.Uint64(), func(windowTo uint64, windowToHash ethCommon.Hash, logBlockHashes map[uint64]ethCommon.Hash) {
+		// set last block to storage
+		if err := rl.storageClient.Put([]byte(lastRootBlockKey), []byte(strconv.FormatUint(windowTo, 10)), nil); err != nil {
+			rl.Logger.Error("rl.storageClient.Put", "Error", err)
+		}
+
+		// track hashes of the window's last block and of blocks with logs to detect reorgs
+		rl.storeBlockHash(windowTo, windowToHash)
+		rl.storeLogBlockHashes(logBlockHashes)
+
+		metrics.BlockLag.WithLabelValues(RootChainListenerStr).Set(float64(newHeader.Number.Uint64() - windowTo))
+	}); err != nil {
+		rl.Logger.Error("Error while querying rootchain events, will retry from last processed block", "error", err)
+	}
+}
+
+// checkReorg compares the stored hash of lastBlock and hashes of blocks which produced logs in previous
+// pass with the canonical ones. On mismatch, it returns the latest block which is still canonical,
+// so that the affected range is scanned again.
+func (rl *RootChainListener) checkReorg(lastBlock uint64) (uint64, error) {
+	ancestor, storedHash, canonicalHash, err := rl.checkWindowReorg(lastBlock)
+	if err != nil {
+		return lastBlock, err
+	}
+
+	// logs might come from another fork than the tracked window end
+	orphaned, logStoredHash, logCanonicalHash, err := rl.checkLogBlocks(ancestor)
+	if err != nil {
+		return lastBlock, err
+	}
+	if orphaned != nil {
+		ancestor = *orphaned - 1
+		storedHash, canonicalHash = logStoredHash, logCanonicalHash
+	}
+
+	if ancestor < lastBlock {
+		rl.reportReorg(lastBlock, ancestor, storedHash, canonicalHash)
+	}
+
+	// hashes of blocks with logs are checked once
+	rl.deleteKeys(rootLogBlockKeyPrefix, func(uint64) bool { return true })
+
+	return ancestor, nil
+}
+
+// checkWindowReorg compares the stored hash of lastBlock with the canonical one.
+// On mismatch, it finds the latest tracked window end which is still canonical and returns it
+// along with the stored and canonical hash of lastBlock.
+func (rl *RootChainListener) checkWindowReorg(lastBlock uint64) (uint64, ethCommon.Hash, ethCommon.Hash, error) {
+	storedHash, ok := rl.getBlockHash(lastBlock)
+	if !ok {
+		// block hash is not tracked (first run), nothing to compare
+		return lastBlock, storedHash, storedHash, nil
+	}
+
+	header, err := rl.chainClient.HeaderByNumber(context.Background(), big.NewInt(0).SetUint64(lastBlock))
+	if err != nil {
+		return lastBlock, storedHash, storedHash, err
+	}
+
+	if header.Hash() == storedHash {
+		return lastBlock, storedHash, storedHash, nil
+	}
+
+	// find common ancestor among tracked window ends, fall back to max reorg depth
+	ancestor := uint64(0)
+	if lastBlock > maxRootReorgDepth {
+		ancestor = lastBlock - maxRootReorgDepth
+	}
+
+	iter := rl.storageClient.NewIterator(levelUtil.BytesPrefix([]byte(rootBlockHashKeyPrefix)), nil)
+	for ok := iter.Last(); ok; ok = iter.Prev() {
+		number := binary.BigEndian.Uint64(iter.Key()[len(rootBlockHashKeyPrefix):])
+		if number >= lastBlock {
+			continue
+		}
+		if number <= ancestor {
+			break
+		}
+
+		ancestorHeader, err := rl.chainClient.HeaderByNumber(context.Background(), big.NewInt(0).SetUint64(number))
+		if err != nil {
+			iter.Release()
+			return lastBlock, storedHash, header.Hash(), err
+		}
+
+		if ancestorHeader.Hash() == ethCommon.BytesToHash(iter.Value()) {
+			ancestor = number
+			break
+		}
+	}
+	iter.Release()
+
+	return ancestor, storedHash, header.Hash(), nil
+}
+
+// checkLogBlocks compares stored hashes of blocks with logs up to maxBlock with the canonical ones
+// and returns the first orphaned block along with its stored and canonical hash, nil if all are canonical
+func (rl *RootChainListener) checkLogBlocks(maxBlock uint64) (*uint64, ethCommon.Hash, ethCommon.Hash, error) {
+	iter := rl.storageClient.NewIterator(levelUtil.BytesPrefix([]byte(rootLogBlockKeyPrefix)), nil)
+	defer iter.Release()
+
+	for iter.Next() {
+		number := binary.BigEndian.Uint64(iter.Key()[len(rootLogBlockKeyPrefix):])
+		if number == 0 {
+			continue
+		}
+		if number > maxBlock {
+			break
+		}
+
+		header, err := rl.chainClient.HeaderByNumber(context.Background(), big.NewInt(0).SetUint64(number))
+		if err != nil {
+			return nil, ethCommon.Hash{}, ethCommon.Hash{}, err
+		}
+
+		if storedHash := ethCommon.BytesToHash(iter.Value()); header.Hash() != storedHash {
+			return &number, storedHash, header.Hash(), nil
+		}
+	}
+
+	return nil, ethCommon.Hash{}, ethCommon.Hash{}, iter.Error()
+}
+
+// reportReorg removes hashes of orphaned blocks and reports the reorg
+func (rl *RootChainListener) reportReorg(lastBlock uint64, ancestor uint64, storedHash ethCommon.Hash, canonicalHash ethCommon.Hash) {
+	rl.deleteBlockHashes(func(number uint64) bool { return number > ancestor })
+
+	metrics.RootchainReorgs.Inc()
+	metrics.RootchainReorgDepth.Set(float64(lastBlock - ancestor))
+
+	rl.Logger.Error("⚠️ Rootchain reorg detected, rescanning affected blocks",
+		"lastBlock", lastBlock,
+		"storedHash", storedHash.Hex(),
+		"canonicalHash", canonicalHash.Hex(),
+		"commonAncestor", ancestor,
+		"depth", lastBlock-ancestor,
+	)
+}
+
+// storeBlockHash stores hash of the last block of a processed window and prunes hashes older than max reorg depth
+func (rl *RootChainListener) storeBlockHash(number uint64, hash ethCommon.Hash) {
+	if err := rl.storageClient.Put(rootBlockKey(rootBlockHashKeyPrefix, number), hash.Bytes(), nil); err != nil {
 		rl.Logger.Error("rl.storageClient.Put", "Error", err)
+		return
+	}
+
+	// prune old hashes
+	if number >= maxRootReorgDepth {
+		rl.deleteBlockHashes(func(n uint64) bool { return n <= number-maxRootReorgDepth })
 	}
+}
 
-	// query events
-	rl.queryAndBroadcastEvents(rootchainContext, fromBlock, toBlock)
+// storeLogBlockHashes stores hashes of blocks which produced logs, they are checked on next pass
+func (rl *RootChainListener) storeLogBlockHashes(hashes map[uint64]ethCommon.Hash) {
+	for number, hash := range hashes {
+		if err := rl.storageClient.Put(rootBlockKey(rootLogBlockKeyPrefix, number), hash.Bytes(), nil); err != nil {
+			rl.Logger.Error("rl.storageClient.Put", "Error", err)
+		}
+	}
 }
 
-func (rl *RootChainListener) queryAndBroadcastEvents(rootchainContext *RootChainListenerContext, fromBlock *big.Int, toBlock *big.Int) {
+// deleteBlockHashes deletes stored block hashes whose block number matches
+func (rl *RootChainListener) deleteBlockHashes(match func(uint64) bool) {
+	rl.deleteKeys(rootBlockHashKeyPrefix, match)
+	rl.deleteKeys(rootLogBlockKeyPrefix, match)
+}
+
+// deleteKeys deletes stored keys with given prefix whose block number matches
+func (rl *RootChainListener) deleteKeys(prefix string, match func(uint64) bool) {
+	iter := rl.storageClient.NewIterator(levelUtil.BytesPrefix([]byte(prefix)), nil)
+	defer iter.Release()
+	for iter.Next() {
+		if !match(binary.BigEndian.Uint64(iter.Key()[len(prefix):])) {
+			continue
+		}
+		if err := rl.storageClient.Delete(iter.Key(), nil); err != nil {
+			rl.Logger.Error("rl.storageClient.Delete", "Error", err)
+		}
+	}
+}
+
+// getBlockHash returns stored hash of processed block
+func (rl *RootChainListener) getBlockHash(number uint64) (ethCommon.Hash, bool) {
+	hashBytes, err := rl.storageClient.Get(rootBlockKey(rootBlockHashKeyPrefix, number), nil)
+	if err != nil {
+		return ethCommon.Hash{}, false
+	}
+	return ethCommon.BytesToHash(hashBytes), true
+}
+
+// queryAndBroadcastWindows queries and broadcasts events from fromBlock to toBlock in windows of
+// MainchainLogsWindow blocks and calls onWindow with the hash of the window's last block and hashes of
+// blocks which produced logs after all events of a window are enqueued. Stops at the first window which fails.
+func (rl *RootChainListener) queryAndBroadcastWindows(rootchainContext *RootChainListenerContext, fromBlock uint64, toBlock uint64, onWindow func(uint64, ethCommon.Hash, map[uint64]ethCommon.Hash)) error {
+	window := helper.GetConfig().MainchainLogsWindow
+	if window == 0 {
+		window = helper.DefaultMainchainLogsWindow
+	}
+
+	for windowFrom := fromBlock; windowFrom <= toBlock; {
+		windowTo := toBlock
+		if toBlock-windowFrom >= window {
+			windowTo = windowFrom + window - 1
+		}
+
+		toHeader, err := rl.chainClient.HeaderByNumber(context.Background(), big.NewInt(0).SetUint64(windowTo))
+		if err != nil {
+			rl.Logger.Error("Error while fetching rootchain header", "blockNumber", windowTo, "error", err)
+			return err
+		}
+
+		logBlockHashes, err := rl.queryAndBroadcastEvents(rootchainContext, big.NewInt(0).SetUint64(windowFrom), big.NewInt(0).SetUint64(windowTo), toHeader.Hash())
+		if err != nil {
+			return err
+		}
+
+		if onWindow != nil {
+			onWindow(windowTo, toHeader.Hash(), logBlockHashes)
+		}
+
+		windowFrom = windowTo + 1
+	}
+
+	return nil
+}
+
+// filterLogs filters rootchain logs, splitting the range in half while provider reports too large result
+func (rl *RootChainListener) filterLogs(query ethereum.FilterQuery) ([]types.Log, error) {
+	rl.waitForLogsRateLimit()
+
+	logs, err := rl.contractConnector.MainChainClient.FilterLogs(context.Background(), query)
+	if err == nil || !isLogsResultTooLarge(err) {
+		return logs, err
+	}
+
+	fromBlock := query.FromBlock.Uint64()
+	toBlock := query.ToBlock.Uint64()
+	if fromBlock >= toBlock {
+		return nil, err
+	}
+
+	middle := fromBlock + (toBlock-fromBlock)/2
+	rl.Logger.Info("Log filter result too large, retrying with smaller range", "fromBlock", fromBlock, "toBlock", toBlock, "middle", middle)
+
+	firstQuery := query
+	firstQuery.FromBlock = big.NewInt(0).SetUint64(fromBlock)
+	firstQuery.ToBlock = big.NewInt(0).SetUint64(middle)
+	firstLogs, err := rl.filterLogs(firstQuery)
+	if err != nil {
+		return nil, err
+	}
+
+	secondQuery := query
+	secondQuery.FromBlock = big.NewInt(0).SetUint64(middle + 1)
+	secondQuery.ToBlock = big.NewInt(0).SetUint64(toBlock)
+	secondLogs, err := rl.filterLogs(secondQuery)
+	if err != nil {
+		return nil, err
+	}
+
+	return append(firstLogs, secondLogs...), nil
+}
+
+// waitForLogsRateLimit blocks until next log filter request is allowed
+func (rl *RootChainListener) waitForLogsRateLimit() {
+	rateLimit := helper.GetConfig().MainchainLogsRateLimit
+	if rateLimit == 0 {
+		return
+	}
+
+	rl.logsRateMutex.Lock()
+	defer rl.logsRateMutex.Unlock()
+
+	next := rl.lastLogsRequest.Add(time.Second / time.Duration(rateLimit))
+	if wait := time.Until(next); wait > 0 {
+		time.Sleep(wait)
+	}
+	rl.lastLogsRequest = time.Now()
+}
+
+// queryAndBroadcastEvents enqueues tasks for events of given range and returns hashes of blocks which produced logs
+func (rl *RootChainListener) queryAndBroadcastEvents(rootchainContext *RootChainListenerContext, fromBlock *big.Int, toBlock *big.Int, toBlockHash ethCommon.Hash) (map[uint64]ethCommon.Hash, error) {
 	rl.Logger.Info("Query rootchain event logs", "fromBlock", fromBlock, "toBlock", toBlock)
 
 	// current public key
@@ -161,26 +467,50 @@ func (rl *RootChainListener) queryAndBroadcastEvents(rootchainContext *RootChain
 		chainParams.StateSenderAddress.EthAddress(),
 	}}
 	// get logs from rootchain by filter
-	logs, err := rl.contractConnector.MainChainClient.FilterLogs(context.Background(), query)
+	logs, err := rl.filterLogs(query)
 	if err != nil {
 		rl.Logger.Error("Error while filtering logs", "error", err)
-		return
+		return nil, err
 	} else if len(logs) > 0 {
 		rl.Logger.Debug("New logs found", "numberOfLogs", len(logs))
 	}
 
+	// logs and tracked hash must belong to the same chain, otherwise the window is retried
+	logBlockHashes := make(map[uint64]ethCommon.Hash)
+	for _, vLog := range logs {
+		if vLog.BlockNumber == toBlock.Uint64() && vLog.BlockHash != toBlockHash {
+			rl.Logger.Error("Rootchain reorged while querying logs", "blockNumber", vLog.BlockNumber, "logBlockHash", vLog.BlockHash.Hex(), "headerHash", toBlockHash.Hex())
+			return nil, errRootchainReorged
+		}
+		if hash, ok := logBlockHashes[vLog.BlockNumber]; ok && hash != vLog.BlockHash {
+			rl.Logger.Error("Rootchain reorged while querying logs", "blockNumber", vLog.BlockNumber, "logBlockHash", vLog.BlockHash.Hex(), "otherLogBlockHash", hash.Hex())
+			return nil, errRootchainReorged
+		}
+		logBlockHashes[vLog.BlockNumber] = vLog.BlockHash
+	}
+
+	// validator set is fetched once per window to calculate ownership of its events
+	var validators []*hmTypes.Validator
+	if len(logs) > 0 {
+		if validators, err = util.GetCurrentValidators(rl.cliCtx); err != nil {
+			return nil, err
+		}
+	}
+
 	// process filtered log
 	for _, vLog := range logs {
+		var sendErr error
 		topic := vLog.Topics[0].Bytes()
 		for _, abiObject := range rl.abis {
 			selectedEvent := helper.EventByID(abiObject, topic)
 			logBytes, _ := json.Marshal(vLog)
-			if selectedEvent != nil {
+			if selectedEvent != nil && rl.isEventSelected(selectedEvent.Name) {
 				rl.Logger.Debug("ReceivedEvent", "eventname", selectedEvent.Name)
+				metrics.EventsSeen.WithLabelValues(RootChainListenerStr, selectedEvent.Name).Inc()
 				switch selectedEvent.Name {
 				case "NewHeaderBlock":
-					if isCurrentValidator, delay := util.CalculateTaskDelay(rl.cliCtx); isCurrentValidator {
-						rl.sendTaskWithDelay("sendCheckpointAckToHeimdall", selectedEvent.Name, logBytes, delay)
+					if isCurrentValidator, delay := util.CalculateTaskOwnership(validators, vLog.TxHash, uint64(vLog.Index)); isCurrentValidator {
+						sendErr = rl.sendTaskWithDelay("sendCheckpointAckToHeimdall", selectedEvent.Name, logBytes, delay)
 					}
 				case "Staked":
 					event := new(stakinginfo.StakinginfoStaked)
@@ -190,11 +520,11 @@ func (rl *RootChainListener) queryAndBroadcastEvents(rootchainContext *RootChain
 					if bytes.Equal(event.SignerPubkey, pubkeyBytes) {
 						// topup has to be processed first before validator join. so adding delay.
 						delay := util.TaskDelayBetweenEachVal
-						rl.sendTaskWithDelay("sendValidatorJoinToHeimdall", selectedEvent.Name, logBytes, delay)
-					} else if isCurrentValidator, delay := util.CalculateTaskDelay(rl.cliCtx); isCurrentValidator {
+						sendErr = rl.sendTaskWithDelay("sendValidatorJoinToHeimdall", selectedEvent.Name, logBytes, delay)
+					} else if isCurrentValidator, delay := util.CalculateTaskOwnership(validators, vLog.TxHash, uint64(vLog.Index)); isCurrentValidator {
 						// topup has to be processed first before validator join. so adding delay.
 						delay = delay + util.TaskDelayBetweenEachVal
-						rl.sendTaskWithDelay("sendValidatorJoinToHeimdall", selectedEvent.Name, logBytes, delay)
+						sendErr = rl.sendTaskWithDelay("sendValidatorJoinToHeimdall", selectedEvent.Name, logBytes, delay)
 					}
 
 				case "StakeUpdate":
@@ -203,9 +533,9 @@ func (rl *RootChainListener) queryAndBroadcastEvents(rootchainContext *RootChain
 						rl.Logger.Error("Error while parsing event", "name", selectedEvent.Name, "error", err)
 					}
 					if util.IsEventSender(rl.cliCtx, event.ValidatorId.Uint64()) {
-						rl.sendTaskWithDelay("sendStakeUpdateToHeimdall", selectedEvent.Name, logBytes, 0)
-					} else if isCurrentValidator, delay := util.CalculateTaskDelay(rl.cliCtx); isCurrentValidator {
-						rl.sendTaskWithDelay("sendStakeUpdateToHeimdall", selectedEvent.Name, logBytes, delay)
+						sendErr = rl.sendTaskWithDelay("sendStakeUpdateToHeimdall", selectedEvent.Name, logBytes, 0)
+					} else if isCurrentValidator, delay := util.CalculateTaskOwnership(validators, vLog.TxHash, uint64(vLog.Index)); isCurrentValidator {
+						sendErr = rl.sendTaskWithDelay("sendStakeUpdateToHeimdall", selectedEvent.Name, logBytes, delay)
 					}
 
 				case "SignerChange":
@@ -214,9 +544,9 @@ func (rl *RootChainListener) queryAndBroadcastEvents(rootchainContext *RootChain
 						rl.Logger.Error("Error while parsing event", "name", selectedEvent.Name, "error", err)
 					}
 					if bytes.Equal(event.SignerPubkey, pubkeyBytes) {
-						rl.sendTaskWithDelay("sendSignerChangeToHeimdall", selectedEvent.Name, logBytes, 0)
-					} else if isCurrentValidator, delay := util.CalculateTaskDelay(rl.cliCtx); isCurrentValidator {
-						rl.sendTaskWithDelay("sendSignerChangeToHeimdall", selectedEvent.Name, logBytes, delay)
+						sendErr = rl.sendTaskWithDelay("sendSignerChangeToHeimdall", selectedEvent.Name, logBytes, 0)
+					} else if isCurrentValidator, delay := util.CalculateTaskOwnership(validators, vLog.TxHash, uint64(vLog.Index)); isCurrentValidator {
+						sendErr = rl.sendTaskWithDelay("sendSignerChangeToHeimdall", selectedEvent.Name, logBytes, delay)
 					}
 
 				case "UnstakeInit":
@@ -225,14 +555,14 @@ func (rl *RootChainListener) queryAndBroadcastEvents(rootchainContext *RootChain
 						rl.Logger.Error("Error while parsing event", "name", selectedEvent.Name, "error", err)
 					}
 					if util.IsEventSender(rl.cliCtx, event.ValidatorId.Uint64()) {
-						rl.sendTaskWithDelay("sendUnstakeInitToHeimdall", selectedEvent.Name, logBytes, 0)
-					} else if isCurrentValidator, delay := util.CalculateTaskDelay(rl.cliCtx); isCurrentValidator {
-						rl.sendTaskWithDelay("sendUnstakeInitToHeimdall", selectedEvent.Name, logBytes, delay)
+						sendErr = rl.sendTaskWithDelay("sendUnstakeInitToHeimdall", selectedEvent.Name, logBytes, 0)
+					} else if isCurrentValidator, delay := util.CalculateTaskOwnership(validators, vLog.TxHash, uint64(vLog.Index)); isCurrentValidator {
+						sendErr = rl.sendTaskWithDelay("sendUnstakeInitToHeimdall", selectedEvent.Name, logBytes, delay)
 					}
 
 				case "StateSynced":
-					if isCurrentValidator, delay := util.CalculateTaskDelay(rl.cliCtx); isCurrentValidator {
-						rl.sendTaskWithDelay("sendStateSyncedToHeimdall", selectedEvent.Name, logBytes, delay)
+					if isCurrentValidator, delay := util.CalculateTaskOwnership(validators, vLog.TxHash, uint64(vLog.Index)); isCurrentValidator {
+						sendErr = rl.sendTaskWithDelay("sendStateSyncedToHeimdall", selectedEvent.Name, logBytes, delay)
 					}
 
 				case "TopUpFee":
@@ -241,14 +571,14 @@ func (rl *RootChainListener) queryAndBroadcastEvents(rootchainContext *RootChain
 						rl.Logger.Error("Error while parsing event", "name", selectedEvent.Name, "error", err)
 					}
 					if bytes.Equal(event.User.Bytes(), helper.GetAddress()) {
-						rl.sendTaskWithDelay("sendTopUpFeeToHeimdall", selectedEvent.Name, logBytes, 0)
-					} else if isCurrentValidator, delay := util.CalculateTaskDelay(rl.cliCtx); isCurrentValidator {
-						rl.sendTaskWithDelay("sendTopUpFeeToHeimdall", selectedEvent.Name, logBytes, delay)
+						sendErr = rl.sendTaskWithDelay("sendTopUpFeeToHeimdall", selectedEvent.Name, logBytes, 0)
+					} else if isCurrentValidator, delay := util.CalculateTaskOwnership(validators, vLog.TxHash, uint64(vLog.Index)); isCurrentValidator {
+						sendErr = rl.sendTaskWithDelay("sendTopUpFeeToHeimdall", selectedEvent.Name, logBytes, delay)
 					}
 
 				case "Slashed":
-					if isCurrentValidator, delay := util.CalculateTaskDelay(rl.cliCtx); isCurrentValidator {
-						rl.sendTaskWithDelay("sendTickAckToHeimdall", selectedEvent.Name, logBytes, delay)
+					if isCurrentValidator, delay := util.CalculateTaskOwnership(validators, vLog.TxHash, uint64(vLog.Index)); isCurrentValidator {
+						sendErr = rl.sendTaskWithDelay("sendTickAckToHeimdall", selectedEvent.Name, logBytes, delay)
 					}
 
 				case "UnJailed":
@@ -257,17 +587,24 @@ func (rl *RootChainListener) queryAndBroadcastEvents(rootchainContext *RootChain
 						rl.Logger.Error("Error while parsing event", "name", selectedEvent.Name, "error", err)
 					}
 					if util.IsEventSender(rl.cliCtx, event.ValidatorId.Uint64()) {
-						rl.sendTaskWithDelay("sendUnjailToHeimdall", selectedEvent.Name, logBytes, 0)
-					} else if isCurrentValidator, delay := util.CalculateTaskDelay(rl.cliCtx); isCurrentValidator {
-						rl.sendTaskWithDelay("sendUnjailToHeimdall", selectedEvent.Name, logBytes, delay)
+						sendErr = rl.sendTaskWithDelay("sendUnjailToHeimdall", selectedEvent.Name, logBytes, 0)
+					} else if isCurrentValidator, delay := util.CalculateTaskOwnership(validators, vLog.TxHash, uint64(vLog.Index)); isCurrentValidator {
+						sendErr = rl.sendTaskWithDelay("sendUnjailToHeimdall", selectedEvent.Name, logBytes, delay)
 					}
 				}
 			}
 		}
+
+		// window is not fully enqueued
+		if sendErr != nil {
+			return nil, sendErr
+		}
 	}
+
+	return logBlockHashes, nil
 }
 
-func (rl *RootChainListener) sendTaskWithDelay(taskName string, eventName string, logBytes []byte, delay time.Duration) {
+func (rl *RootChainListener) sendTaskWithDelay(taskName string, eventName string, logBytes []byte, delay time.Duration) error {
 	signature := &tasks.Signature{
 		Name: taskName,
 		Args: []tasks.Arg{
@@ -281,22 +618,57 @@ func (rl *RootChainListener) sendTaskWithDelay(taskName string, eventName string
 			},
 		},
 	}
-	signature.RetryCount = 3
+	signature.RetryCount = queue.DefaultRetryCount
 
 	// add delay for task so that multiple validators won't send same transaction at same time
 	eta := time.Now().Add(delay)
 	signature.ETA = &eta
+	if rl.dryRun {
+		rl.Logger.Info("Dry run, skip sending task", "taskName", taskName, "eventName", eventName, "delayTime", eta, "log", string(logBytes))
+		return nil
+	}
+
 	rl.Logger.Info("Sending task", "taskName", taskName, "currentTime", time.Now(), "delayTime", eta)
 	_, err := rl.queueConnector.Server.SendTask(signature)
 	if err != nil {
 		rl.Logger.Error("Error sending task", "taskName", taskName, "error", err)
 	}
+	return err
 }
 
 //
 // utils
 //
 
+// logsResultTooLargeErrors are provider errors returned when log filter range/result is too large
+var logsResultTooLargeErrors = []string{
+	"query returned more than",   // infura, geth based providers
+	"response size exceeded",     // alchemy
+	"block range is too wide",    // quicknode
+	"exceed maximum block range", // ankr
+	"block range too large",      // erigon based providers
+	"result too large",           // generic
+}
+
+// isLogsResultTooLarge checks if log filter error asks for smaller block range
+func isLogsResultTooLarge(err error) bool {
+	message := strings.ToLower(err.Error())
+	for _, e := range logsResultTooLargeErrors {
+		if strings.Contains(message, e) {
+			return true
+		}
+	}
+	return false
+}
+
+// rootBlockKey returns storage key for processed block hash with given prefix (sorted by block number)
+func rootBlockKey(prefix string, number uint64) []byte {
+	key := make([]byte, len(prefix)+8)
+	copy(key, prefix)
+	binary.BigEndian.PutUint64(key[len(prefix):], number)
+	return key
+}
+
 func (rl *RootChainListener) getRootChainContext() (*RootChainListenerContext, error) {
 	chainmanagerParams, err := util.GetChainmanagerParams(rl.cliCtx)
 	if err != nil {
@@ -0,0 +1,108 @@
+package listener
+
+import (
+	"math/big"
+	"testing"
+
+	ethCommon "github.com/maticnetwork/bor/common"
+	"github.com/maticnetwork/bor/core/types"
+	"github.com/maticnetwork/bor/ethclient"
+	"github.com/maticnetwork/bor/rpc"
+	"github.com/stretchr/testify/require"
+	"github.com/syndtr/goleveldb/leveldb"
+	"github.com/syndtr/goleveldb/leveldb/storage"
+	"github.com/tendermint/tendermint/libs/log"
+)
+
+// fakeHeaderAPI serves canonical headers over eth json-rpc
+type fakeHeaderAPI struct {
+	headers map[uint64]*types.Header
+}
+
+func (api *fakeHeaderAPI) GetBlockByNumber(number rpc.BlockNumber, fullTx bool) (*types.Header, error) {
+	return api.headers[uint64(number.Int64())], nil
+}
+
+func newTestHeader(number uint64, fork string) *types.Header {
+	return &types.Header{Number: new(big.Int).SetUint64(number), Difficulty: big.NewInt(1), Extra: []byte(fork)}
+}
+
+func newTestRootChainListener(t *testing.T, lastBlock uint64) *RootChainListener {
+	api := &fakeHeaderAPI{headers: make(map[uint64]*types.Header)}
+	for number := uint64(1); number <= lastBlock; number++ {
+		api.headers[number] = newTestHeader(number, "canonical")
+	}
+
+	server := rpc.NewServer()
+	require.NoError(t, server.RegisterName("eth", api))
+
+	db, err := leveldb.Open(storage.NewMemStorage(), nil)
+	require.NoError(t, err)
+
+	return &RootChainListener{
+		BaseListener: BaseListener{
+			Logger:        log.NewNopLogger(),
+			chainClient:   ethclient.NewClient(rpc.DialInProc(server)),
+			storageClient: db,
+		},
+	}
+}
+
+func TestCheckReorg(t *testing.T) {
+	t.Run("Canonical", func(t *testing.T) {
+		rl := newTestRootChainListener(t, 20)
+		rl.storeBlockHash(10, newTestHeader(10, "canonical").Hash())
+		rl.storeBlockHash(20, newTestHeader(20, "canonical").Hash())
+		rl.storeLogBlockHashes(map[uint64]ethCommon.Hash{15: newTestHeader(15, "canonical").Hash()})
+
+		ancestor, err := rl.checkReorg(20)
+		require.NoError(t, err)
+		require.Equal(t, uint64(20), ancestor)
+
+		// hashes of blocks with logs are checked once, window ends are kept
+		hasLogBlock, err := rl.storageClient.Has(rootBlockKey(rootLogBlockKeyPrefix, 15), nil)
+		require.NoError(t, err)
+		require.False(t, hasLogBlock)
+		_, ok := rl.getBlockHash(20)
+		require.True(t, ok)
+	})
+
+	t.Run("WindowEndOrphaned", func(t *testing.T) {
+		rl := newTestRootChainListener(t, 20)
+		rl.storeBlockHash(10, newTestHeader(10, "canonical").Hash())
+		rl.storeBlockHash(20, newTestHeader(20, "fork").Hash())
+
+		ancestor, err := rl.checkReorg(20)
+		require.NoError(t, err)
+		require.Equal(t, uint64(10), ancestor)
+
+		_, ok := rl.getBlockHash(20)
+		require.False(t, ok)
+	})
+
+	t.Run("LogBlockOrphaned", func(t *testing.T) {
+		// window end is canonical, but logs of block 15 came from a fork
+		rl := newTestRootChainListener(t, 20)
+		rl.storeBlockHash(10, newTestHeader(10, "canonical").Hash())
+		rl.storeBlockHash(20, newTestHeader(20, "canonical").Hash())
+		rl.storeLogBlockHashes(map[uint64]ethCommon.Hash{
+			12: newTestHeader(12, "canonical").Hash(),
+			15: newTestHeader(15, "fork").Hash(),
+			18: newTestHeader(18, "fork").Hash(),
+		})
+
+		// blocks are rescanned from the first orphaned block
+		ancestor, err := rl.checkReorg(20)
+		require.NoError(t, err)
+		require.Equal(t, uint64(14), ancestor)
+
+		_, ok := rl.getBlockHash(20)
+		require.False(t, ok)
+		_, ok = rl.getBlockHash(10)
+		require.True(t, ok)
+
+		hasLogBlock, err := rl.storageClient.Has(rootBlockKey(rootLogBlockKeyPrefix, 12), nil)
+		require.NoError(t, err)
+		require.False(t, hasLogBlock)
+	})
+}
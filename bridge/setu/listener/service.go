@@ -3,6 +3,7 @@ package listener
 import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/maticnetwork/heimdall/bridge/setu/queue"
+	"github.com/maticnetwork/heimdall/bridge/setu/supervisor"
 	"github.com/maticnetwork/heimdall/bridge/setu/util"
 	"github.com/maticnetwork/heimdall/helper"
 	"github.com/tendermint/tendermint/libs/common"
@@ -17,13 +18,22 @@ const (
 	MaticChainListenerStr = "maticchain"
 )
 
-// var logger = util.Logger().With("service", ListenerServiceStr)
+// processors which are sent tasks by each listener. Listener is started when
+// it is selected with --only or any of its processors is selected.
+var listenerProcessors = map[string][]string{
+	RootChainListenerStr:  {"checkpoint", "staking", "clerk", "fee", "slashing"},
+	MaticChainListenerStr: {"checkpoint"},
+	HeimdallListenerStr:   {"checkpoint", "slashing"},
+}
 
 // ListenerService starts and stops all chain event listeners
 type ListenerService struct {
 	// Base service
 	common.BaseService
 	listeners []Listener
+
+	// restarts failed listeners
+	supervisor *supervisor.Supervisor
 }
 
 // NewListenerService returns new service object for listneing to events
@@ -32,39 +42,56 @@ func NewListenerService(cdc *codec.Codec, queueConnector *queue.QueueConnector,
 	var logger = util.Logger().With("service", ListenerServiceStr)
 
 	// creating listener object
-	listenerService := &ListenerService{}
+	listenerService := &ListenerService{
+		supervisor: supervisor.NewSupervisor(logger, supervisor.DefaultMinBackoff, supervisor.DefaultMaxBackoff),
+	}
 
 	listenerService.BaseService = *common.NewBaseService(logger, ListenerServiceStr, listenerService)
 
 	rootchainListener := NewRootChainListener()
 	rootchainListener.BaseListener = *NewBaseListener(cdc, queueConnector, httpClient, helper.GetMainClient(), RootChainListenerStr, rootchainListener)
-	listenerService.listeners = append(listenerService.listeners, rootchainListener)
 
 	maticchainListener := &MaticChainListener{}
 	maticchainListener.BaseListener = *NewBaseListener(cdc, queueConnector, httpClient, helper.GetMaticClient(), MaticChainListenerStr, maticchainListener)
-	listenerService.listeners = append(listenerService.listeners, maticchainListener)
 
 	heimdallListener := &HeimdallListener{}
 	heimdallListener.BaseListener = *NewBaseListener(cdc, queueConnector, httpClient, nil, HeimdallListenerStr, heimdallListener)
-	listenerService.listeners = append(listenerService.listeners, heimdallListener)
+
+	// select listeners
+	for _, listener := range []Listener{rootchainListener, maticchainListener, heimdallListener} {
+		if isListenerSelected(listener.String()) {
+			listenerService.listeners = append(listenerService.listeners, listener)
+			listenerService.supervisor.Add(ListenerServiceStr, listener)
+		}
+	}
 
 	return listenerService
 }
 
+// Listeners returns names of selected listeners
+func (listenerService *ListenerService) Listeners() []string {
+	names := make([]string, 0, len(listenerService.listeners))
+	for _, listener := range listenerService.listeners {
+		names = append(names, listener.String())
+	}
+	return names
+}
+
+// Health returns health of selected listeners
+func (listenerService *ListenerService) Health() []supervisor.Health {
+	return listenerService.supervisor.Health()
+}
+
 // OnStart starts new block subscription
 func (listenerService *ListenerService) OnStart() error {
 	if err := listenerService.BaseService.OnStart(); err != nil {
 		listenerService.Logger.Error("OnStart | OnStart", "Error", err)
 	} // Always call the overridden method.
 
-	// start chain listeners
-	for _, listener := range listenerService.listeners {
-		if err := listener.Start(); err != nil {
-			listenerService.Logger.Error("OnStart | Start", "Error", err)
-		}
-	}
+	// start chain listeners, failed ones are restarted
+	listenerService.supervisor.Start()
 
-	listenerService.Logger.Info("all listeners Started")
+	listenerService.Logger.Info("all listeners Started", "listeners", listenerService.Listeners())
 	return nil
 }
 
@@ -72,11 +99,23 @@ func (listenerService *ListenerService) OnStart() error {
 func (listenerService *ListenerService) OnStop() {
 	listenerService.BaseService.OnStop() // Always call the overridden method.
 
-	// start chain listeners
-	for _, listener := range listenerService.listeners {
-		listener.Stop()
-	}
+	// stop chain listeners
+	listenerService.supervisor.Stop()
 
 	listenerService.Logger.Info("all listeners stopped")
 
 }
+
+// isListenerSelected returns true if listener or any of its processors is selected
+func isListenerSelected(name string) bool {
+	if util.IsServiceSelected(name) {
+		return true
+	}
+
+	for _, processor := range listenerProcessors[name] {
+		if util.IsServiceSelected(processor) {
+			return true
+		}
+	}
+	return false
+}
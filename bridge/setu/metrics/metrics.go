@@ -0,0 +1,120 @@
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const (
+	namespace = "bridge"
+
+	// BroadcastSuccess is the status label for successful broadcasts
+	BroadcastSuccess = "success"
+	// BroadcastFailure is the status label for failed broadcasts
+	BroadcastFailure = "failure"
+)
+
+var (
+	// EventsSeen counts events seen by listeners per chain and event type
+	EventsSeen = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "listener",
+		Name:      "events_seen_total",
+		Help:      "Number of events seen by listeners",
+	}, []string{"chain", "event"})
+
+	// BlockLag tracks number of blocks between chain tip and listener cursor
+	BlockLag = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Subsystem: "listener",
+		Name:      "block_lag",
+		Help:      "Number of blocks between chain tip and listener cursor",
+	}, []string{"chain"})
+
+	// RootchainReorgs counts detected rootchain reorgs
+	RootchainReorgs = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "listener",
+		Name:      "rootchain_reorgs_total",
+		Help:      "Number of rootchain reorgs detected by rootchain listener",
+	})
+
+	// RootchainReorgDepth tracks depth of the last detected rootchain reorg
+	RootchainReorgDepth = prometheus.NewGauge(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Subsystem: "listener",
+		Name:      "rootchain_reorg_depth",
+		Help:      "Depth of the last rootchain reorg detected by rootchain listener",
+	})
+
+	// TasksSent counts tasks sent to queue per task name
+	TasksSent = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "queue",
+		Name:      "tasks_sent_total",
+		Help:      "Number of tasks sent to queue",
+	}, []string{"task"})
+
+	// TasksFailed counts tasks failed after all retries per task name
+	TasksFailed = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "queue",
+		Name:      "tasks_failed_total",
+		Help:      "Number of tasks failed after all retries",
+	}, []string{"task"})
+
+	// ProcessorLatency tracks time taken by processor handlers per task name
+	ProcessorLatency = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "processor",
+		Name:      "latency_seconds",
+		Help:      "Time taken by processor handlers",
+		Buckets:   []float64{0.1, 0.5, 1, 5, 10, 30, 60, 300, 900, 1800},
+	}, []string{"task", "status"})
+
+	// Broadcasts counts broadcasted txs per chain and status
+	Broadcasts = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "broadcaster",
+		Name:      "txs_total",
+		Help:      "Number of txs broadcasted by bridge",
+	}, []string{"chain", "status"})
+
+	// ComponentUp tracks if supervised listeners and processors are running
+	ComponentUp = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Subsystem: "supervisor",
+		Name:      "component_up",
+		Help:      "Whether supervised listener or processor is running (1) or not (0)",
+	}, []string{"kind", "component"})
+
+	// ComponentRestarts counts restarts of failed listeners and processors
+	ComponentRestarts = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "supervisor",
+		Name:      "component_restarts_total",
+		Help:      "Number of restarts of failed listeners and processors",
+	}, []string{"kind", "component"})
+)
+
+func init() {
+	prometheus.MustRegister(
+		EventsSeen,
+		BlockLag,
+		RootchainReorgs,
+		RootchainReorgDepth,
+		TasksSent,
+		TasksFailed,
+		ProcessorLatency,
+		Broadcasts,
+		ComponentUp,
+		ComponentRestarts,
+	)
+}
+
+// BroadcastStatus returns status label for broadcast error
+func BroadcastStatus(err error) string {
+	if err != nil {
+		return BroadcastFailure
+	}
+	return BroadcastSuccess
+}
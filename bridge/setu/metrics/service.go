@@ -0,0 +1,74 @@
+package metrics
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"github.com/tendermint/tendermint/libs/common"
+
+	"github.com/maticnetwork/heimdall/bridge/setu/util"
+)
+
+const (
+	metricsServiceStr = "metrics"
+
+	shutdownTimeout = 5 * time.Second
+)
+
+// MetricsService serves prometheus metrics over http
+type MetricsService struct {
+	// Base service
+	common.BaseService
+
+	server *http.Server
+}
+
+// NewMetricsService returns new service object for prometheus metrics server
+func NewMetricsService(listenAddr string) *MetricsService {
+	var logger = util.Logger().With("service", metricsServiceStr)
+
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", promhttp.Handler())
+
+	metricsService := &MetricsService{
+		server: &http.Server{
+			Addr:    listenAddr,
+			Handler: mux,
+		},
+	}
+
+	metricsService.BaseService = *common.NewBaseService(logger, metricsServiceStr, metricsService)
+	return metricsService
+}
+
+// OnStart starts metrics http server
+func (metricsService *MetricsService) OnStart() error {
+	if err := metricsService.BaseService.OnStart(); err != nil {
+		metricsService.Logger.Error("OnStart | OnStart", "Error", err)
+	} // Always call the overridden method.
+
+	go func() {
+		metricsService.Logger.Info("Starting metrics server", "address", metricsService.server.Addr)
+		if err := metricsService.server.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+			metricsService.Logger.Error("Error while serving metrics server", "error", err)
+		}
+	}()
+
+	return nil
+}
+
+// OnStop stops metrics http server
+func (metricsService *MetricsService) OnStop() {
+	metricsService.BaseService.OnStop() // Always call the overridden method.
+
+	ctx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+	defer cancel()
+
+	if err := metricsService.server.Shutdown(ctx); err != nil {
+		metricsService.Logger.Error("Error while stopping metrics server", "error", err)
+	}
+
+	metricsService.Logger.Info("metrics server stopped")
+}
@@ -0,0 +1,151 @@
+package notify
+
+import (
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/maticnetwork/bor/common"
+)
+
+// Severity is severity of notification event
+type Severity int
+
+const (
+	// Info is for events which don't need any action
+	Info Severity = iota
+	// Warning is for events which need attention soon
+	Warning
+	// Critical is for events which need immediate action
+	Critical
+)
+
+var severityNames = map[Severity]string{
+	Info:     "info",
+	Warning:  "warning",
+	Critical: "critical",
+}
+
+// String returns name of severity
+func (s Severity) String() string {
+	if name, ok := severityNames[s]; ok {
+		return name
+	}
+
+	return fmt.Sprintf("severity(%d)", int(s))
+}
+
+// MarshalText encodes severity by its name
+func (s Severity) MarshalText() ([]byte, error) {
+	return []byte(s.String()), nil
+}
+
+// ParseSeverity returns severity by its name
+func ParseSeverity(name string) (Severity, error) {
+	for severity, severityName := range severityNames {
+		if strings.EqualFold(name, severityName) {
+			return severity, nil
+		}
+	}
+
+	return Info, fmt.Errorf("Invalid severity %v", name)
+}
+
+// EventType is type of notification event
+type EventType string
+
+// Notification event types
+const (
+	CheckpointSubmitFailed  EventType = "checkpoint-submit-failed"
+	CheckpointNoAckProposed EventType = "checkpoint-no-ack-proposed"
+	HeimdallTxFailed        EventType = "heimdall-tx-failed"
+	RootchainTxFailed       EventType = "rootchain-tx-failed"
+	RootchainTxStuck        EventType = "rootchain-tx-stuck"
+	LowFeeBalance           EventType = "low-fee-balance"
+	LowEthBalance           EventType = "low-eth-balance"
+)
+
+// Event is a notification published by bridge services
+type Event struct {
+	Type     EventType         `json:"type"`
+	Severity Severity          `json:"severity"`
+	Message  string            `json:"message"`
+	Fields   map[string]string `json:"fields,omitempty"`
+	Source   string            `json:"source,omitempty"` // address of the validator running bridge
+	Time     time.Time         `json:"time"`
+}
+
+// NewEvent creates new event
+func NewEvent(eventType EventType, severity Severity, message string, fields map[string]string) Event {
+	if fields == nil {
+		fields = make(map[string]string)
+	}
+
+	return Event{
+		Type:     eventType,
+		Severity: severity,
+		Message:  message,
+		Fields:   fields,
+		Time:     time.Now().UTC(),
+	}
+}
+
+// NewCheckpointSubmitFailedEvent is published when checkpoint couldn't be submitted to rootchain
+func NewCheckpointSubmitFailedEvent(start uint64, end uint64, err error) Event {
+	return NewEvent(CheckpointSubmitFailed, Critical, "Error sending checkpoint to rootchain", map[string]string{
+		"start": strconv.FormatUint(start, 10),
+		"end":   strconv.FormatUint(end, 10),
+		"error": err.Error(),
+	})
+}
+
+// NewCheckpointNoAckProposedEvent is published when bridge proposes checkpoint no-ack to heimdall
+func NewCheckpointNoAckProposedEvent(lastCheckpointTime time.Time) Event {
+	return NewEvent(CheckpointNoAckProposed, Warning, "Checkpoint no-ack proposed, no checkpoint acknowledged in time", map[string]string{
+		"lastCheckpointTime": lastCheckpointTime.UTC().Format(time.RFC3339),
+	})
+}
+
+// NewHeimdallTxFailedEvent is published when tx couldn't be broadcasted to heimdall
+func NewHeimdallTxFailedEvent(msgType string, err error) Event {
+	return NewEvent(HeimdallTxFailed, Warning, "Error broadcasting tx to heimdall", map[string]string{
+		"msg":   msgType,
+		"error": err.Error(),
+	})
+}
+
+// NewRootchainTxFailedEvent is published when tx couldn't be broadcasted to rootchain
+func NewRootchainTxFailedEvent(to common.Address, nonce uint64, err error) Event {
+	return NewEvent(RootchainTxFailed, Critical, "Error broadcasting tx to rootchain", map[string]string{
+		"to":    to.Hex(),
+		"nonce": strconv.FormatUint(nonce, 10),
+		"error": err.Error(),
+	})
+}
+
+// NewRootchainTxStuckEvent is published when pending rootchain tx can't be replaced as it reached max gas price
+func NewRootchainTxStuckEvent(to common.Address, nonce uint64, gasPrice *big.Int) Event {
+	return NewEvent(RootchainTxStuck, Critical, "Rootchain tx is stuck at max gas price", map[string]string{
+		"to":       to.Hex(),
+		"nonce":    strconv.FormatUint(nonce, 10),
+		"gasPrice": gasPrice.String(),
+	})
+}
+
+// NewLowFeeBalanceEvent is published when heimdall account has not enough fee tokens
+func NewLowFeeBalanceEvent(balance *big.Int, minBalance *big.Int) Event {
+	return NewEvent(LowFeeBalance, Warning, "Fee token balance on heimdall is low", map[string]string{
+		"balance":    balance.String(),
+		"minBalance": minBalance.String(),
+	})
+}
+
+// NewLowEthBalanceEvent is published when main chain account has not enough ether
+func NewLowEthBalanceEvent(balance *big.Int, minBalance *big.Int) Event {
+	return NewEvent(LowEthBalance, Critical, "ETH balance on main chain is low", map[string]string{
+		"balance":    balance.String(),
+		"minBalance": minBalance.String(),
+	})
+}
@@ -0,0 +1,181 @@
+package notify
+
+import (
+	"context"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/tendermint/tendermint/libs/common"
+
+	"github.com/maticnetwork/heimdall/bridge/setu/util"
+)
+
+const (
+	notifyServiceStr = "notify"
+
+	// number of events kept while sinks are busy, further events are dropped
+	eventBufferSize = 100
+)
+
+var (
+	defaultService   *NotificationService
+	defaultServiceMu sync.RWMutex
+)
+
+// SetDefaultService sets service used by Publish. nil disables notifications.
+func SetDefaultService(service *NotificationService) {
+	defaultServiceMu.Lock()
+	defer defaultServiceMu.Unlock()
+
+	defaultService = service
+}
+
+// Publish publishes event to default notification service, if any
+func Publish(event Event) {
+	defaultServiceMu.RLock()
+	service := defaultService
+	defaultServiceMu.RUnlock()
+
+	if service != nil {
+		service.Publish(event)
+	}
+}
+
+// target is a sink with its severity filter and rate limit state
+type target struct {
+	sink        Sink
+	name        string
+	minSeverity Severity
+
+	lastSent   map[EventType]time.Time
+	suppressed map[EventType]int
+}
+
+// NotificationService delivers published events to webhook sinks.
+//
+// Events of same type are sent at most once per rate limit interval to each sink, events
+// in between are counted and reported with the next one.
+type NotificationService struct {
+	// Base service
+	common.BaseService
+
+	source    string
+	rateLimit time.Duration
+	targets   []*target
+
+	events chan Event
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+// NewNotificationService returns new service object for webhook targets
+func NewNotificationService(targets []Target, rateLimit time.Duration, source string) *NotificationService {
+	var logger = util.Logger().With("service", notifyServiceStr)
+
+	notificationService := &NotificationService{
+		source:    source,
+		rateLimit: rateLimit,
+		events:    make(chan Event, eventBufferSize),
+	}
+
+	for _, t := range targets {
+		notificationService.AddSink(NewWebhookSink(t.URL, t.Format), t.Format, t.MinSeverity)
+	}
+
+	notificationService.BaseService = *common.NewBaseService(logger, notifyServiceStr, notificationService)
+	return notificationService
+}
+
+// AddSink adds sink receiving events of at least given severity. Must be called before service starts.
+func (ns *NotificationService) AddSink(sink Sink, name string, minSeverity Severity) {
+	ns.targets = append(ns.targets, &target{
+		sink:        sink,
+		name:        name,
+		minSeverity: minSeverity,
+		lastSent:    make(map[EventType]time.Time),
+		suppressed:  make(map[EventType]int),
+	})
+}
+
+// Publish queues event for delivery. It never blocks, event is dropped if queue is full.
+func (ns *NotificationService) Publish(event Event) {
+	if event.Source == "" {
+		event.Source = ns.source
+	}
+
+	select {
+	case ns.events <- event:
+	default:
+		ns.Logger.Error("Notification queue is full, dropping event", "type", event.Type, "severity", event.Severity)
+	}
+}
+
+// OnStart starts delivering events
+func (ns *NotificationService) OnStart() error {
+	if err := ns.BaseService.OnStart(); err != nil {
+		ns.Logger.Error("OnStart | OnStart", "Error", err)
+	} // Always call the overridden method.
+
+	ctx, cancel := context.WithCancel(context.Background())
+	ns.cancel = cancel
+
+	ns.wg.Add(1)
+	go func() {
+		defer ns.wg.Done()
+
+		ns.Logger.Info("Starting notification service", "sinks", len(ns.targets), "rateLimit", ns.rateLimit)
+		for {
+			select {
+			case event := <-ns.events:
+				ns.deliver(ctx, event)
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return nil
+}
+
+// OnStop stops delivering events
+func (ns *NotificationService) OnStop() {
+	ns.BaseService.OnStop() // Always call the overridden method.
+
+	ns.cancel()
+	ns.wg.Wait()
+
+	ns.Logger.Info("Notification service stopped")
+}
+
+// deliver sends event to all targets accepting it
+func (ns *NotificationService) deliver(ctx context.Context, event Event) {
+	for _, t := range ns.targets {
+		if event.Severity < t.minSeverity {
+			continue
+		}
+
+		if last, ok := t.lastSent[event.Type]; ok && ns.rateLimit > 0 && event.Time.Sub(last) < ns.rateLimit {
+			t.suppressed[event.Type]++
+			ns.Logger.Debug("Notification rate limited", "sink", t.name, "type", event.Type)
+			continue
+		}
+
+		targetEvent := event
+		if suppressed := t.suppressed[event.Type]; suppressed > 0 {
+			targetEvent.Fields = make(map[string]string, len(event.Fields)+1)
+			for key, value := range event.Fields {
+				targetEvent.Fields[key] = value
+			}
+			targetEvent.Fields["suppressed"] = strconv.Itoa(suppressed)
+		}
+
+		if err := t.sink.Send(ctx, targetEvent); err != nil {
+			ns.Logger.Error("Error sending notification", "sink", t.name, "type", event.Type, "error", err)
+			continue
+		}
+
+		t.lastSent[event.Type] = event.Time
+		delete(t.suppressed, event.Type)
+	}
+}
@@ -0,0 +1,112 @@
+package notify
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+)
+
+type recordingSink struct {
+	events []Event
+}
+
+func (s *recordingSink) Send(ctx context.Context, event Event) error {
+	s.events = append(s.events, event)
+	return nil
+}
+
+func init() {
+	viper.SetDefault("log_level", "error")
+}
+
+func TestParseTargets(t *testing.T) {
+	targets, err := ParseTargets("slack:critical:https://hooks.slack.com/services/XXX, json:Info:http://localhost:8080/events")
+	require.NoError(t, err)
+	require.Equal(t, []Target{
+		{Format: SlackFormat, MinSeverity: Critical, URL: "https://hooks.slack.com/services/XXX"},
+		{Format: JSONFormat, MinSeverity: Info, URL: "http://localhost:8080/events"},
+	}, targets)
+
+	targets, err = ParseTargets("")
+	require.NoError(t, err)
+	require.Empty(t, targets)
+
+	_, err = ParseTargets("http://localhost:8080/events")
+	require.Error(t, err)
+	_, err = ParseTargets("xml:info:http://localhost:8080/events")
+	require.Error(t, err)
+	_, err = ParseTargets("json:urgent:http://localhost:8080/events")
+	require.Error(t, err)
+}
+
+func TestDeliverFiltersAndRateLimits(t *testing.T) {
+	ns := NewNotificationService(nil, time.Minute, "0x01")
+	all, critical := &recordingSink{}, &recordingSink{}
+	ns.AddSink(all, "all", Info)
+	ns.AddSink(critical, "critical", Critical)
+
+	ctx := context.Background()
+	noAck := NewCheckpointNoAckProposedEvent(time.Now())
+	ns.deliver(ctx, noAck)
+	require.Len(t, all.events, 1)
+	require.Empty(t, critical.events)
+
+	// same type within rate limit is suppressed, other types are not
+	failed := NewCheckpointSubmitFailedEvent(0, 255, errors.New("out of gas"))
+	ns.deliver(ctx, failed)
+	ns.deliver(ctx, failed)
+	require.Len(t, all.events, 2)
+	require.Len(t, critical.events, 1)
+
+	// suppressed events are reported with the next one
+	failed.Time = failed.Time.Add(2 * time.Minute)
+	ns.deliver(ctx, failed)
+	require.Len(t, critical.events, 2)
+	require.Equal(t, "1", critical.events[1].Fields["suppressed"])
+	require.Equal(t, "255", critical.events[1].Fields["end"])
+	_, ok := failed.Fields["suppressed"]
+	require.False(t, ok)
+}
+
+func TestWebhookSink(t *testing.T) {
+	var body map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		data, err := ioutil.ReadAll(r.Body)
+		require.NoError(t, err)
+		require.NoError(t, json.Unmarshal(data, &body))
+	}))
+	defer server.Close()
+
+	event := NewLowFeeBalanceEvent(big.NewInt(1), big.NewInt(100))
+	event.Source = "0x01"
+
+	// generic json
+	require.NoError(t, NewWebhookSink(server.URL, JSONFormat).Send(context.Background(), event))
+	require.Equal(t, string(LowFeeBalance), body["type"])
+	require.Equal(t, "warning", body["severity"])
+	require.Equal(t, "0x01", body["source"])
+	require.Equal(t, "1", body["fields"].(map[string]interface{})["balance"])
+
+	// slack
+	require.NoError(t, NewWebhookSink(server.URL, SlackFormat).Send(context.Background(), event))
+	require.Contains(t, body["text"], "[WARNING] low-fee-balance")
+	attachment := body["attachments"].([]interface{})[0].(map[string]interface{})
+	require.Equal(t, "warning", attachment["color"])
+	require.Len(t, attachment["fields"], 2)
+
+	// non 2xx status is an error
+	failing := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer failing.Close()
+	require.Error(t, NewWebhookSink(failing.URL, JSONFormat).Send(context.Background(), event))
+}
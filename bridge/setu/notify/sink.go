@@ -0,0 +1,173 @@
+package notify
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+)
+
+// Webhook payload formats
+const (
+	JSONFormat  = "json"
+	SlackFormat = "slack"
+)
+
+const webhookTimeout = 10 * time.Second
+
+// Sink delivers events to an external system
+type Sink interface {
+	Send(ctx context.Context, event Event) error
+}
+
+// Target is a webhook which receives events of at least given severity
+type Target struct {
+	Format      string
+	MinSeverity Severity
+	URL         string
+}
+
+// ParseTargets parses comma separated webhook targets, each as `<format>:<min severity>:<url>`.
+// eg. "slack:critical:https://hooks.slack.com/services/XXX,json:info:http://localhost:8080/events"
+func ParseTargets(targets string) ([]Target, error) {
+	var result []Target
+	for _, entry := range strings.Split(targets, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+
+		parts := strings.SplitN(entry, ":", 3)
+		if len(parts) != 3 {
+			return nil, fmt.Errorf("Invalid webhook target %v, expected <format>:<min severity>:<url>", entry)
+		}
+
+		format := strings.ToLower(parts[0])
+		if format != JSONFormat && format != SlackFormat {
+			return nil, fmt.Errorf("Invalid webhook format %v, expected %v or %v", parts[0], JSONFormat, SlackFormat)
+		}
+
+		severity, err := ParseSeverity(parts[1])
+		if err != nil {
+			return nil, err
+		}
+
+		result = append(result, Target{Format: format, MinSeverity: severity, URL: parts[2]})
+	}
+
+	return result, nil
+}
+
+// WebhookSink posts events as json to an url
+type WebhookSink struct {
+	url    string
+	format string
+	client *http.Client
+}
+
+// NewWebhookSink creates webhook sink for given format
+func NewWebhookSink(url string, format string) *WebhookSink {
+	return &WebhookSink{
+		url:    url,
+		format: format,
+		client: &http.Client{Timeout: webhookTimeout},
+	}
+}
+
+// Send posts event to webhook
+func (s *WebhookSink) Send(ctx context.Context, event Event) error {
+	var payload interface{} = event
+	if s.format == SlackFormat {
+		payload = slackPayload(event)
+	}
+
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequest(http.MethodPost, s.url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := s.client.Do(req.WithContext(ctx))
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("Webhook returned status %v", resp.StatusCode)
+	}
+
+	return nil
+}
+
+//
+// slack
+//
+
+var slackColors = map[Severity]string{
+	Info:     "good",
+	Warning:  "warning",
+	Critical: "danger",
+}
+
+type slackField struct {
+	Title string `json:"title"`
+	Value string `json:"value"`
+	Short bool   `json:"short"`
+}
+
+type slackAttachment struct {
+	Fallback string       `json:"fallback"`
+	Color    string       `json:"color"`
+	Title    string       `json:"title"`
+	Text     string       `json:"text"`
+	Fields   []slackField `json:"fields,omitempty"`
+	Footer   string       `json:"footer,omitempty"`
+	Ts       int64        `json:"ts"`
+}
+
+type slackMessage struct {
+	Text        string            `json:"text"`
+	Attachments []slackAttachment `json:"attachments"`
+}
+
+// slackPayload formats event as slack incoming webhook message
+func slackPayload(event Event) slackMessage {
+	keys := make([]string, 0, len(event.Fields))
+	for key := range event.Fields {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	fields := make([]slackField, 0, len(keys))
+	for _, key := range keys {
+		fields = append(fields, slackField{
+			Title: key,
+			Value: event.Fields[key],
+			Short: key != "error",
+		})
+	}
+
+	title := fmt.Sprintf("[%v] %v", strings.ToUpper(event.Severity.String()), event.Type)
+	return slackMessage{
+		Text: fmt.Sprintf("%v: %v", title, event.Message),
+		Attachments: []slackAttachment{{
+			Fallback: fmt.Sprintf("%v: %v", title, event.Message),
+			Color:    slackColors[event.Severity],
+			Title:    title,
+			Text:     event.Message,
+			Fields:   fields,
+			Footer:   event.Source,
+			Ts:       event.Time.Unix(),
+		}},
+	}
+}
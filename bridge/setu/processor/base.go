@@ -4,6 +4,8 @@ import (
 	"github.com/cosmos/cosmos-sdk/client"
 	cliContext "github.com/cosmos/cosmos-sdk/client/context"
 	"github.com/cosmos/cosmos-sdk/codec"
+	bor "github.com/maticnetwork/bor"
+	"github.com/maticnetwork/bor/common"
 	"github.com/spf13/viper"
 	"github.com/syndtr/goleveldb/leveldb"
 
@@ -92,6 +94,31 @@ func (bp *BaseProcessor) String() string {
 	return bp.name
 }
 
+// sendRootchainTx broadcasts tx to rootchain. Rootchain tx monitor follows it and calls onOutcome once it is confirmed, failed or replaced.
+func (bp *BaseProcessor) sendRootchainTx(to common.Address, data []byte, onOutcome broadcaster.RootchainTxCallback) (*broadcaster.RootchainTx, error) {
+	return bp.txBroadcaster.BroadcastToRootchain(bor.CallMsg{To: &to, Data: data}, onOutcome)
+}
+
+// getProcessedStatus returns if rootchain event is processed on heimdall using idempotency store.
+// Store is best-effort, so it only tells about recorded events. Returns false as second value
+// if event is not recorded, heimdall is queried then.
+func (bp *BaseProcessor) getProcessedStatus(txHash string, logIndex uint64) (bool, bool) {
+	store := util.NewIdempotencyStore(bp.storageClient)
+
+	heimdallTxHash, ok, err := store.GetRootchainEvent(txHash, logIndex)
+	if err != nil {
+		bp.Logger.Error("Error fetching event from idempotency store", "txHash", txHash, "logIndex", logIndex, "error", err)
+		return false, false
+	}
+
+	if ok {
+		bp.Logger.Debug("Event is already processed on heimdall", "txHash", txHash, "logIndex", logIndex, "heimdallTxHash", heimdallTxHash)
+		return true, true
+	}
+
+	return false, false
+}
+
 // OnStop stops all necessary go routines
 func (bp *BaseProcessor) Stop() {
 	// override to stop any go-routines in individual processors
@@ -16,6 +16,8 @@ import (
 	"github.com/maticnetwork/bor/common"
 	"github.com/maticnetwork/bor/core/types"
 	authTypes "github.com/maticnetwork/heimdall/auth/types"
+	"github.com/maticnetwork/heimdall/bridge/setu/broadcaster"
+	"github.com/maticnetwork/heimdall/bridge/setu/notify"
 	"github.com/maticnetwork/heimdall/bridge/setu/util"
 	chainmanagerTypes "github.com/maticnetwork/heimdall/chainmanager/types"
 	checkpointTypes "github.com/maticnetwork/heimdall/checkpoint/types"
@@ -171,7 +173,16 @@ func (cp *CheckpointProcessor) sendCheckpointToHeimdall(headerBlockStr string) (
 // 1. check if i am the current proposer.
 // 2. check if this checkpoint has to be submitted to rootchain
 // 3. if so, create and broadcast checkpoint transaction to rootchain
-func (cp *CheckpointProcessor) sendCheckpointToRootchain(eventBytes string, blockHeight int64) error {
+func (cp *CheckpointProcessor) sendCheckpointToRootchain(eventBytes string, blockHeight int64) (err error) {
+	var startBlock uint64
+	var endBlock uint64
+	var txHash string
+
+	defer func() {
+		if err != nil {
+			notify.Publish(notify.NewCheckpointSubmitFailedEvent(startBlock, endBlock, err))
+		}
+	}()
 
 	cp.Logger.Info("Received sendCheckpointToRootchain request", "eventBytes", eventBytes, "blockHeight", blockHeight)
 	var event = sdk.StringEvent{}
@@ -193,10 +204,6 @@ func (cp *CheckpointProcessor) sendCheckpointToRootchain(eventBytes string, bloc
 		return err
 	}
 
-	var startBlock uint64
-	var endBlock uint64
-	var txHash string
-
 	for _, attr := range event.Attributes {
 		if attr.Key == checkpointTypes.AttributeKeyStartBlock {
 			startBlock, _ = strconv.ParseUint(attr.Value, 10, 64)
@@ -284,6 +291,7 @@ func (cp *CheckpointProcessor) sendCheckpointAckToHeimdall(eventName string, che
 			event.Root,
 			hmTypes.BytesToHeimdallHash(log.TxHash.Bytes()),
 			uint64(log.Index),
+			checkpointContext.ChainmanagerParams.ChainParams.BorChainID,
 		)
 
 		// return broadcast to heimdall
@@ -324,7 +332,7 @@ func (cp *CheckpointProcessor) handleCheckpointNoAck() {
 		// if i am the proposer and NoAck is required, then propose No-Ack
 		if isProposer {
 			// send Checkpoint No-Ack to heimdall
-			if err := cp.proposeCheckpointNoAck(); err != nil {
+			if err := cp.proposeCheckpointNoAck(lastCreatedAt); err != nil {
 				cp.Logger.Error("Error proposing Checkpoint No-Ack ", "error", err)
 				return
 			}
@@ -516,22 +524,73 @@ func (cp *CheckpointProcessor) createAndSendCheckpointToRootchain(checkpointCont
 		chainParams := checkpointContext.ChainmanagerParams.ChainParams
 		// root chain address
 		rootChainAddress := chainParams.RootChainAddress.EthAddress()
-		// root chain instance
-		rootChainInstance, err := cp.contractConnector.GetRootChainInstance(rootChainAddress)
+
+		data, err := cp.contractConnector.RootChainABI.Pack("submitHeaderBlock", sideTxData, sigs)
 		if err != nil {
-			cp.Logger.Info("Error while creating rootchain instance", "error", err)
+			cp.Logger.Error("Unable to pack tx for submitHeaderBlock", "error", err)
 			return err
 		}
 
-		if err := cp.contractConnector.SendCheckpoint(sideTxData, sigs, rootChainAddress, rootChainInstance); err != nil {
-			cp.Logger.Info("Error submitting checkpoint to rootchain", "error", err)
-			return err
-		}
+		cp.Logger.Debug("Sending new checkpoint",
+			"sigs", hex.EncodeToString(sigs),
+			"data", hex.EncodeToString(sideTxData),
+		)
+
+		return cp.submitCheckpointToRootchain(checkpointContext, rootChainAddress, data, start, end)
+	}
+
+	return nil
+}
+
+// submitCheckpointToRootchain sends checkpoint tx to rootchain and handles its outcome once rootchain tx monitor reports it
+func (cp *CheckpointProcessor) submitCheckpointToRootchain(checkpointContext *CheckpointContext, rootChainAddress common.Address, data []byte, start uint64, end uint64) error {
+	rootchainTx, err := cp.sendRootchainTx(rootChainAddress, data, func(tx broadcaster.RootchainTx) {
+		cp.handleCheckpointTxOutcome(checkpointContext, rootChainAddress, data, start, end, tx)
+	})
+	if err != nil {
+		cp.Logger.Info("Error submitting checkpoint to rootchain", "error", err)
+		return err
 	}
 
+	if rootchainTx.Status == broadcaster.RootchainTxShadow {
+		cp.Logger.Info("Recorded new checkpoint in shadow mode", "start", start, "end", end)
+		return nil
+	}
+
+	cp.Logger.Info("Sent new checkpoint to rootchain", "txHash", rootchainTx.TxHash.Hex(), "nonce", rootchainTx.Nonce, "start", start, "end", end)
 	return nil
 }
 
+// handleCheckpointTxOutcome resubmits checkpoint if its tx was replaced and checkpoint is still not on rootchain
+func (cp *CheckpointProcessor) handleCheckpointTxOutcome(checkpointContext *CheckpointContext, rootChainAddress common.Address, data []byte, start uint64, end uint64, tx broadcaster.RootchainTx) {
+	switch tx.Status {
+	case broadcaster.RootchainTxConfirmed:
+		cp.Logger.Info("Submitted new checkpoint to rootchain successfully", "txHash", tx.TxHash.Hex(), "blockNumber", tx.BlockNumber, "start", start, "end", end)
+		return
+	case broadcaster.RootchainTxPending:
+		cp.Logger.Error("Checkpoint tx is stuck on rootchain", "txHash", tx.TxHash.Hex(), "nonce", tx.Nonce, "gasPrice", tx.GasPrice, "start", start, "end", end)
+		return
+	}
+
+	cp.Logger.Info("Checkpoint tx is not confirmed on rootchain", "status", tx.Status, "txHash", tx.TxHash.Hex(), "start", start, "end", end)
+
+	// checkpoint might have been submitted by someone else
+	shouldSend, err := cp.shouldSendCheckpoint(checkpointContext, start, end)
+	if err != nil || !shouldSend {
+		return
+	}
+
+	// reverted tx would revert again, only a replaced one is sent again
+	if tx.Status != broadcaster.RootchainTxReplaced {
+		cp.Logger.Error("Checkpoint tx reverted on rootchain", "txHash", tx.TxHash.Hex(), "blockNumber", tx.BlockNumber, "start", start, "end", end)
+		return
+	}
+
+	if err := cp.submitCheckpointToRootchain(checkpointContext, rootChainAddress, data, start, end); err != nil {
+		cp.Logger.Error("Error resubmitting checkpoint to rootchain", "start", start, "end", end, "error", err)
+	}
+}
+
 // fetchDividendAccountRoot - fetches dividend accountroothash
 func (cp *CheckpointProcessor) fetchDividendAccountRoot() (accountroothash hmTypes.HeimdallHash, err error) {
 	cp.Logger.Info("Sending Rest call to Get Dividend AccountRootHash")
@@ -627,7 +686,7 @@ func (cp *CheckpointProcessor) checkIfNoAckIsRequired(checkpointContext *Checkpo
 }
 
 // proposeCheckpointNoAck - sends Checkpoint NoAck to heimdall
-func (cp *CheckpointProcessor) proposeCheckpointNoAck() (err error) {
+func (cp *CheckpointProcessor) proposeCheckpointNoAck(lastCreatedAt int64) (err error) {
 	// send NO ACK
 	msg := checkpointTypes.NewMsgCheckpointNoAck(
 		hmTypes.BytesToHeimdallAddress(helper.GetAddress()),
@@ -640,6 +699,7 @@ func (cp *CheckpointProcessor) proposeCheckpointNoAck() (err error) {
 	}
 
 	cp.Logger.Info("No-ack transaction sent successfully")
+	notify.Publish(notify.NewCheckpointNoAckProposedEvent(time.Unix(lastCreatedAt, 0)))
 	return nil
 }
 
@@ -703,6 +763,16 @@ func (cp *CheckpointProcessor) getCheckpointContext() (*CheckpointContext, error
 		return nil, err
 	}
 
+	// follow adaptive checkpoint length
+	if checkpointParams.AdaptiveCheckpointLength {
+		checkpointLength, err := util.GetCheckpointLength(cp.cliCtx)
+		if err != nil {
+			cp.Logger.Error("Error while fetching checkpoint length", "error", err)
+			return nil, err
+		}
+		checkpointParams.AvgCheckpointLength = checkpointLength.Length
+	}
+
 	return &CheckpointContext{
 		ChainmanagerParams: chainmanagerParams,
 		CheckpointParams:   checkpointParams,
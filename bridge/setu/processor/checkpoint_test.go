@@ -0,0 +1,57 @@
+package processor_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/maticnetwork/bor/common"
+	"github.com/maticnetwork/bor/crypto"
+	"github.com/stretchr/testify/require"
+
+	"github.com/maticnetwork/heimdall/app"
+	"github.com/maticnetwork/heimdall/bridge/setu/harness"
+	checkpointTypes "github.com/maticnetwork/heimdall/checkpoint/types"
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+func TestSendCheckpointAckToHeimdall(t *testing.T) {
+	root := common.BytesToHash(crypto.Keccak256([]byte("checkpoint")))
+
+	// checkpoint waiting for ack in buffer
+	h, err := harness.New(harness.Config{
+		Genesis: func(h *harness.Harness, appState app.GenesisState) (app.GenesisState, error) {
+			checkpointState := checkpointTypes.GetGenesisStateFromAppState(appState)
+			checkpointState.BufferedCheckpoint = &hmTypes.Checkpoint{
+				Proposer:   hmTypes.BytesToHeimdallAddress(h.ValidatorAddress.Bytes()),
+				StartBlock: 0,
+				EndBlock:   255,
+				RootHash:   hmTypes.BytesToHeimdallHash(root.Bytes()),
+				BorChainID: h.Bor.ChainID().String(),
+				TimeStamp:  uint64(time.Now().Unix()),
+			}
+			appState[checkpointTypes.ModuleName] = checkpointTypes.ModuleCdc.MustMarshalJSON(checkpointState)
+			return appState, nil
+		},
+	})
+	require.NoError(t, err)
+	defer h.Close()
+
+	vLog, err := h.RootChain.SubmitCheckpoint(h.ValidatorAddress, 0, 255, root)
+	require.NoError(t, err)
+
+	require.NoError(t, h.SendTask("sendCheckpointAckToHeimdall", "NewHeaderBlock", vLog))
+
+	// deliver tx and approve side-tx
+	h.ProduceBlocks(3)
+
+	ctx := h.Context()
+	require.Equal(t, uint64(1), h.Heimdall.App.CheckpointKeeper.GetACKCount(ctx))
+
+	checkpoint, err := h.Heimdall.App.CheckpointKeeper.GetLastCheckpoint(ctx)
+	require.NoError(t, err)
+	require.Equal(t, uint64(255), checkpoint.EndBlock)
+	require.Equal(t, root.Bytes(), checkpoint.RootHash.Bytes())
+
+	_, err = h.Heimdall.App.CheckpointKeeper.GetCheckpointFromBuffer(ctx)
+	require.Error(t, err)
+}
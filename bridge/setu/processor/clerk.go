@@ -117,6 +117,10 @@ func (cp *ClerkProcessor) sendStateSyncedToHeimdall(eventName string, logBytes s
 
 // isOldTx  checks if tx is already processed or not
 func (cp *ClerkProcessor) isOldTx(cliCtx cliContext.CLIContext, txHash string, logIndex uint64) (bool, error) {
+	if status, ok := cp.getProcessedStatus(txHash, logIndex); ok {
+		return status, nil
+	}
+
 	queryParam := map[string]interface{}{
 		"txhash":   txHash,
 		"logindex": logIndex,
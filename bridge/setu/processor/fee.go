@@ -90,6 +90,10 @@ func (fp *FeeProcessor) sendTopUpFeeToHeimdall(eventName string, logBytes string
 
 // isOldTx  checks if tx is already processed or not
 func (fp *FeeProcessor) isOldTx(cliCtx cliContext.CLIContext, txHash string, logIndex uint64) (bool, error) {
+	if status, ok := fp.getProcessedStatus(txHash, logIndex); ok {
+		return status, nil
+	}
+
 	queryParam := map[string]interface{}{
 		"txhash":   txHash,
 		"logindex": logIndex,
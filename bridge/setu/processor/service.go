@@ -2,12 +2,12 @@ package processor
 
 import (
 	"github.com/cosmos/cosmos-sdk/codec"
-	"github.com/spf13/viper"
 	"github.com/tendermint/tendermint/libs/common"
 	httpClient "github.com/tendermint/tendermint/rpc/client"
 
 	"github.com/maticnetwork/heimdall/bridge/setu/broadcaster"
 	"github.com/maticnetwork/heimdall/bridge/setu/queue"
+	"github.com/maticnetwork/heimdall/bridge/setu/supervisor"
 	"github.com/maticnetwork/heimdall/bridge/setu/util"
 	"github.com/maticnetwork/heimdall/helper"
 )
@@ -25,6 +25,9 @@ type ProcessorService struct {
 	queueConnector *queue.QueueConnector
 
 	processors []Processor
+
+	// restarts failed processors
+	supervisor *supervisor.Supervisor
 }
 
 // NewProcessorService returns new service object for processing queue msg
@@ -38,6 +41,7 @@ func NewProcessorService(
 	// creating processor object
 	processorService := &ProcessorService{
 		queueConnector: queueConnector,
+		supervisor:     supervisor.NewSupervisor(logger, supervisor.DefaultMinBackoff, supervisor.DefaultMaxBackoff),
 	}
 
 	contractCaller, err := helper.NewContractCaller()
@@ -79,35 +83,17 @@ func NewProcessorService(
 	// Select processors
 	//
 
-	// add into processor list
-	startAll := viper.GetBool("all")
-	onlyServices := viper.GetStringSlice("only")
-
-	if startAll {
-		processorService.processors = append(processorService.processors,
-			checkpointProcessor,
-			stakingProcessor,
-			clerkProcessor,
-			feeProcessor,
-			spanProcessor,
-			slashingProcessor,
-		)
-	} else {
-		for _, service := range onlyServices {
-			switch service {
-			case "checkpoint":
-				processorService.processors = append(processorService.processors, checkpointProcessor)
-			case "staking":
-				processorService.processors = append(processorService.processors, stakingProcessor)
-			case "clerk":
-				processorService.processors = append(processorService.processors, clerkProcessor)
-			case "fee":
-				processorService.processors = append(processorService.processors, feeProcessor)
-			case "span":
-				processorService.processors = append(processorService.processors, spanProcessor)
-			case "slashing":
-				processorService.processors = append(processorService.processors, slashingProcessor)
-			}
+	for _, processor := range []Processor{
+		checkpointProcessor,
+		stakingProcessor,
+		clerkProcessor,
+		feeProcessor,
+		spanProcessor,
+		slashingProcessor,
+	} {
+		if util.IsServiceSelected(processor.String()) {
+			processorService.processors = append(processorService.processors, processor)
+			processorService.supervisor.Add("processor", processor)
 		}
 	}
 
@@ -118,17 +104,31 @@ func NewProcessorService(
 	return processorService
 }
 
+// Processors returns names of selected processors
+func (processorService *ProcessorService) Processors() []string {
+	names := make([]string, 0, len(processorService.processors))
+	for _, processor := range processorService.processors {
+		names = append(names, processor.String())
+	}
+	return names
+}
+
+// Health returns health of selected processors
+func (processorService *ProcessorService) Health() []supervisor.Health {
+	return processorService.supervisor.Health()
+}
+
 // OnStart starts new block subscription
 func (processorService *ProcessorService) OnStart() error {
 	if err := processorService.BaseService.OnStart(); err != nil {
 		processorService.Logger.Error("OnStart | OnStart", "Error", err)
 	} // Always call the overridden method.
 
-	// start processors
+	// register tasks once, processors are restarted by supervisor if they fail to start
 	for _, processor := range processorService.processors {
 		processor.RegisterTasks()
-		go processor.Start()
 	}
+	processorService.supervisor.Start()
 
 	processorService.Logger.Info("all processors Started")
 	return nil
@@ -137,10 +137,8 @@ func (processorService *ProcessorService) OnStart() error {
 // OnStop stops all necessary go routines
 func (processorService *ProcessorService) OnStop() {
 	processorService.BaseService.OnStop() // Always call the overridden method.
-	// start chain listeners
-	for _, processor := range processorService.processors {
-		processor.Stop()
-	}
+	// stop processors
+	processorService.supervisor.Stop()
 
 	processorService.Logger.Info("all processors stopped")
 }
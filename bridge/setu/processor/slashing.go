@@ -12,6 +12,7 @@ import (
 	"github.com/maticnetwork/bor/common"
 	"github.com/maticnetwork/bor/core/types"
 	authTypes "github.com/maticnetwork/heimdall/auth/types"
+	"github.com/maticnetwork/heimdall/bridge/setu/broadcaster"
 	"github.com/maticnetwork/heimdall/bridge/setu/util"
 	chainmanagerTypes "github.com/maticnetwork/heimdall/chainmanager/types"
 	"github.com/maticnetwork/heimdall/contracts/stakinginfo"
@@ -320,21 +321,56 @@ func (sp *SlashingProcessor) createAndSendTickToRootchain(height int64, txHash [
 	chainParams := slashingContrext.ChainmanagerParams.ChainParams
 	slashManagerAddress := chainParams.SlashManagerAddress.EthAddress()
 
-	// slashmanage instance
-	slashManagerInstance, err := sp.contractConnector.GetSlashManagerInstance(slashManagerAddress)
+	data, err := sp.contractConnector.SlashManagerABI.Pack("updateSlashedAmounts", sideTxData, sigs)
 	if err != nil {
-		sp.Logger.Info("Error while creating slashmanager instance", "error", err)
+		sp.Logger.Error("Unable to pack tx for updateSlashedAmounts", "error", err)
 		return err
 	}
 
-	if err := sp.contractConnector.SendTick(sideTxData, sigs, slashManagerAddress, slashManagerInstance); err != nil {
+	sp.Logger.Info("Sending new tick",
+		"sigs", hex.EncodeToString(sigs),
+		"data", hex.EncodeToString(sideTxData),
+	)
+
+	return sp.submitTickToRootchain(slashManagerAddress, data)
+}
+
+// submitTickToRootchain sends tick tx to slashmanager and handles its outcome once rootchain tx monitor reports it
+func (sp *SlashingProcessor) submitTickToRootchain(slashManagerAddress common.Address, data []byte) error {
+	rootchainTx, err := sp.sendRootchainTx(slashManagerAddress, data, func(tx broadcaster.RootchainTx) {
+		sp.handleTickTxOutcome(slashManagerAddress, data, tx)
+	})
+	if err != nil {
 		sp.Logger.Info("Error submitting tick to slashManager contract", "error", err)
 		return err
 	}
 
+	if rootchainTx.Status == broadcaster.RootchainTxShadow {
+		sp.Logger.Info("Recorded new tick in shadow mode")
+		return nil
+	}
+
+	sp.Logger.Info("Sent new tick to slashmanager", "txHash", rootchainTx.TxHash.Hex(), "nonce", rootchainTx.Nonce)
 	return nil
 }
 
+// handleTickTxOutcome resubmits tick if its tx was replaced
+func (sp *SlashingProcessor) handleTickTxOutcome(slashManagerAddress common.Address, data []byte, tx broadcaster.RootchainTx) {
+	switch tx.Status {
+	case broadcaster.RootchainTxConfirmed:
+		sp.Logger.Info("Submitted new tick to slashmanager successfully", "txHash", tx.TxHash.Hex(), "blockNumber", tx.BlockNumber)
+	case broadcaster.RootchainTxPending:
+		sp.Logger.Error("Tick tx is stuck on rootchain", "txHash", tx.TxHash.Hex(), "nonce", tx.Nonce, "gasPrice", tx.GasPrice)
+	case broadcaster.RootchainTxReplaced:
+		sp.Logger.Info("Tick tx replaced on rootchain, resubmitting", "txHash", tx.TxHash.Hex(), "nonce", tx.Nonce)
+		if err := sp.submitTickToRootchain(slashManagerAddress, data); err != nil {
+			sp.Logger.Error("Error resubmitting tick to slashmanager", "error", err)
+		}
+	default:
+		sp.Logger.Error("Tick tx reverted on rootchain", "status", tx.Status, "txHash", tx.TxHash.Hex(), "blockNumber", tx.BlockNumber)
+	}
+}
+
 // fetchLatestSlashInoBytes - fetches latest slashInfoBytes
 func (sp *SlashingProcessor) fetchLatestSlashInoBytes() (slashInfoBytes hmTypes.HexBytes, err error) {
 	sp.Logger.Info("Sending Rest call to Get Latest SlashInfoBytes")
@@ -399,6 +435,10 @@ func (sp *SlashingProcessor) validateTickSlashInfo(slashInfoList []*hmTypes.Vali
 
 // isOldTx  checks if tx is already processed or not
 func (sp *SlashingProcessor) isOldTx(cliCtx cliContext.CLIContext, txHash string, logIndex uint64) (bool, error) {
+	if status, ok := sp.getProcessedStatus(txHash, logIndex); ok {
+		return status, nil
+	}
+
 	queryParam := map[string]interface{}{
 		"txhash":   txHash,
 		"logindex": logIndex,
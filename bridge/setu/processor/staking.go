@@ -307,6 +307,10 @@ func (sp *StakingProcessor) sendSignerChangeToHeimdall(eventName string, logByte
 
 // isOldTx  checks if tx is already processed or not
 func (sp *StakingProcessor) isOldTx(cliCtx cliContext.CLIContext, txHash string, logIndex uint64) (bool, error) {
+	if status, ok := sp.getProcessedStatus(txHash, logIndex); ok {
+		return status, nil
+	}
+
 	queryParam := map[string]interface{}{
 		"txhash":   txHash,
 		"logindex": logIndex,
@@ -0,0 +1,54 @@
+package processor_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/maticnetwork/bor/common"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+
+	"github.com/maticnetwork/heimdall/bridge/setu/harness"
+	"github.com/maticnetwork/heimdall/helper"
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+func TestSendValidatorJoinToHeimdall(t *testing.T) {
+	key := secp256k1.GenPrivKey()
+	signer := common.BytesToAddress(key.PubKey().Address().Bytes())
+
+	h, err := harness.New(harness.Config{Accounts: []common.Address{signer}})
+	require.NoError(t, err)
+	defer h.Close()
+
+	// 100 tokens
+	amount, _ := big.NewInt(0).SetString("100000000000000000000", 10)
+	pubkey := helper.GetPubObjects(key.PubKey())
+
+	vLog, err := h.StakingInfo.Emit("Staked",
+		signer,
+		big.NewInt(2), // validator id
+		big.NewInt(1), // nonce
+		big.NewInt(1), // activation epoch
+		amount,
+		amount,
+		pubkey[1:],
+	)
+	require.NoError(t, err)
+
+	require.NoError(t, h.SendTask("sendValidatorJoinToHeimdall", "Staked", vLog))
+
+	// deliver tx and approve side-tx
+	h.ProduceBlocks(3)
+
+	validator, ok := h.Heimdall.App.StakingKeeper.GetValidatorFromValID(h.Context(), hmTypes.NewValidatorID(2))
+	require.True(t, ok)
+	require.Equal(t, hmTypes.BytesToHeimdallAddress(signer.Bytes()), validator.Signer)
+	require.Equal(t, int64(100), validator.VotingPower)
+	require.Equal(t, uint64(1), validator.StartEpoch)
+
+	// same event doesn't add validator again
+	require.NoError(t, h.SendTask("sendValidatorJoinToHeimdall", "Staked", vLog))
+	h.ProduceBlocks(3)
+	require.Len(t, h.Heimdall.App.StakingKeeper.GetAllValidators(h.Context()), 2)
+}
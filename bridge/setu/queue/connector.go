@@ -1,18 +1,36 @@
 package queue
 
 import (
+	"fmt"
+
+	"github.com/spf13/viper"
 	"github.com/streadway/amqp"
 	"github.com/tendermint/tendermint/libs/log"
 
 	"github.com/RichardKnop/machinery/v1"
+	eagerBackend "github.com/RichardKnop/machinery/v1/backends/eager"
+	nullBackend "github.com/RichardKnop/machinery/v1/backends/null"
+	eagerBroker "github.com/RichardKnop/machinery/v1/brokers/eager"
 	"github.com/RichardKnop/machinery/v1/config"
 
 	"github.com/maticnetwork/heimdall/bridge/setu/util"
+	"github.com/maticnetwork/heimdall/helper"
 )
 
 type QueueConnector struct {
 	logger log.Logger
 	Server *machinery.Server
+
+	// failed task counter and dead-letter queue
+	stats *statsBackend
+
+	// worker processing registered tasks
+	worker *machinery.Worker
+
+	// queue backend (amqp or leveldb)
+	backend string
+	// amqp dialer
+	dialer string
 }
 
 const (
@@ -20,43 +38,144 @@ const (
 	QueueName = "machinery_tasks"
 )
 
-func NewQueueConnector(dialer string) *QueueConnector {
-	// amqp dialer
-	_, err := amqp.Dial(dialer)
-	if err != nil {
-		panic(err)
-	}
+// NewQueueConnector creates queue connector for given backend
+func NewQueueConnector(backend string, dialer string) (*QueueConnector, error) {
+	var server *machinery.Server
+
+	switch backend {
+	case "", helper.AmqpQueueBackend:
+		backend = helper.AmqpQueueBackend
+
+		// amqp dialer
+		if _, err := amqp.Dial(dialer); err != nil {
+			return nil, err
+		}
 
-	var cnf = &config.Config{
-		Broker:        dialer,
-		DefaultQueue:  QueueName,
-		ResultBackend: dialer,
-		AMQP: &config.AMQPConfig{
-			Exchange:     "machinery_exchange",
-			ExchangeType: "direct",
-			BindingKey:   "machinery_task",
-		},
+		var cnf = &config.Config{
+			Broker:        dialer,
+			DefaultQueue:  QueueName,
+			ResultBackend: dialer,
+			NoUnixSignals: true, // bridge drains worker on stop signal
+			AMQP: &config.AMQPConfig{
+				Exchange:     "machinery_exchange",
+				ExchangeType: "direct",
+				BindingKey:   "machinery_task",
+			},
+		}
+
+		var err error
+		if server, err = machinery.NewServer(cnf); err != nil {
+			return nil, err
+		}
+	case helper.LevelDBQueueBackend:
+		var cnf = &config.Config{
+			Broker:        helper.LevelDBQueueBackend,
+			DefaultQueue:  QueueName,
+			ResultBackend: "null",
+			NoUnixSignals: true, // bridge drains worker on stop signal
+		}
+
+		// tasks are stored in bridge db
+		bridgeDB := util.GetBridgeDBInstance(viper.GetString(util.BridgeDBFlag))
+		if bridgeDB == nil {
+			return nil, fmt.Errorf("Unable to open bridge db for queue")
+		}
+
+		server = machinery.NewServerWithBrokerBackend(cnf, NewLevelDBBroker(cnf, bridgeDB), nullBackend.New())
+	case helper.MemoryQueueBackend:
+		var cnf = &config.Config{
+			Broker:        helper.MemoryQueueBackend,
+			DefaultQueue:  QueueName,
+			ResultBackend: "eager",
+			NoUnixSignals: true,
+		}
+
+		server = machinery.NewServerWithBrokerBackend(cnf, NewMemoryBroker(), eagerBackend.New())
+	default:
+		return nil, fmt.Errorf("Invalid queue backend %v", backend)
 	}
 
-	server, err := machinery.NewServer(cnf)
-	if err != nil {
-		// do something with the error
+	// exhausted tasks are stored in bridge db
+	bridgeDB := util.GetBridgeDBInstance(viper.GetString(util.BridgeDBFlag))
+	if bridgeDB == nil {
+		return nil, fmt.Errorf("Unable to open bridge db for dead-letter queue")
 	}
 
+	// count failed tasks
+	stats := newStatsBackend(server.GetBackend(), NewDeadLetterQueue(bridgeDB))
+	server.SetBackend(stats)
+
 	// queue connector
 	connector := QueueConnector{
-		logger: util.Logger().With("module", "QueueConnector"),
-		Server: server,
+		logger:  util.Logger().With("module", "QueueConnector"),
+		Server:  server,
+		stats:   stats,
+		backend: backend,
+		dialer:  dialer,
 	}
 
 	// connector
-	return &connector
+	return &connector, nil
 }
 
 // StartWorker - starts worker to process registered tasks
 func (qc *QueueConnector) StartWorker() {
 	worker := qc.Server.NewWorker("invoke-processor", 10)
-	qc.logger.Info("Starting machinery worker")
-	errors := make(chan error)
+	qc.logger.Info("Starting machinery worker", "backend", qc.backend)
+
+	// tasks are processed by worker while being sent
+	if broker, ok := qc.Server.GetBroker().(eagerBroker.Mode); ok {
+		broker.AssignWorker(worker)
+		return
+	}
+
+	qc.worker = worker
+	errors := make(chan error, 1)
 	worker.LaunchAsync(errors)
+
+	go func() {
+		if err := <-errors; err != nil {
+			qc.logger.Error("Machinery worker stopped", "error", err)
+		}
+	}()
+}
+
+// StopWorker stops consuming new tasks and waits for tasks being processed
+func (qc *QueueConnector) StopWorker() {
+	if qc.worker == nil {
+		return
+	}
+
+	qc.logger.Info("Stopping machinery worker, waiting for running tasks to finish")
+	qc.worker.Quit()
+	qc.worker = nil
+	qc.logger.Info("Machinery worker stopped")
+}
+
+// PurgeQueue removes all tasks waiting in the queue
+func (qc *QueueConnector) PurgeQueue() error {
+	if broker, ok := qc.Server.GetBroker().(*LevelDBBroker); ok {
+		return broker.Purge()
+	}
+
+	// tasks are never waiting in memory queue
+	if qc.backend == helper.MemoryQueueBackend {
+		return nil
+	}
+
+	// amqp dialer
+	conn, err := amqp.Dial(qc.dialer)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	// initialize exchange
+	channel, err := conn.Channel()
+	if err != nil {
+		return err
+	}
+
+	_, err = channel.QueuePurge(QueueName, false)
+	return err
 }
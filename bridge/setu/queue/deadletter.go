@@ -0,0 +1,145 @@
+package queue
+
+import (
+	"encoding/json"
+	"errors"
+	"sort"
+	"time"
+
+	"github.com/RichardKnop/machinery/v1/tasks"
+	"github.com/syndtr/goleveldb/leveldb"
+	levelUtil "github.com/syndtr/goleveldb/leveldb/util"
+)
+
+const (
+	// DefaultRetryCount is the number of retries of a task before it is moved to dead-letter queue
+	DefaultRetryCount = 3
+
+	deadLetterKeyPrefix = "dlq-" // storage key prefix for dead letters (dlq-<uuid>)
+)
+
+// ErrDeadLetterNotFound is returned when there is no dead letter for given task uuid
+var ErrDeadLetterNotFound = errors.New("dead letter not found")
+
+// TaskAttempt represents a single attempt to process a task
+type TaskAttempt struct {
+	StartedAt  time.Time `json:"started_at"`
+	FinishedAt time.Time `json:"finished_at"`
+	Status     string    `json:"status"`
+}
+
+// DeadLetter represents a task which has no retries left
+type DeadLetter struct {
+	UUID      string           `json:"uuid"`
+	Name      string           `json:"name"`
+	Signature *tasks.Signature `json:"signature"`
+	LastError string           `json:"last_error"`
+	Attempts  []TaskAttempt    `json:"attempts"`
+	FailedAt  time.Time        `json:"failed_at"`
+}
+
+// DeadLetterQueue stores exhausted tasks in bridge db
+type DeadLetterQueue struct {
+	db *leveldb.DB
+}
+
+// NewDeadLetterQueue creates dead-letter queue on given db
+func NewDeadLetterQueue(db *leveldb.DB) *DeadLetterQueue {
+	return &DeadLetterQueue{db: db}
+}
+
+// Put stores dead letter
+func (dlq *DeadLetterQueue) Put(deadLetter *DeadLetter) error {
+	value, err := json.Marshal(deadLetter)
+	if err != nil {
+		return err
+	}
+
+	return dlq.db.Put(deadLetterKey(deadLetter.UUID), value, nil)
+}
+
+// Get returns dead letter for given task uuid
+func (dlq *DeadLetterQueue) Get(uuid string) (*DeadLetter, error) {
+	value, err := dlq.db.Get(deadLetterKey(uuid), nil)
+	if err == leveldb.ErrNotFound {
+		return nil, ErrDeadLetterNotFound
+	} else if err != nil {
+		return nil, err
+	}
+
+	var deadLetter DeadLetter
+	if err := json.Unmarshal(value, &deadLetter); err != nil {
+		return nil, err
+	}
+
+	return &deadLetter, nil
+}
+
+// List returns all dead letters ordered by failure time
+func (dlq *DeadLetterQueue) List() ([]*DeadLetter, error) {
+	iter := dlq.db.NewIterator(levelUtil.BytesPrefix([]byte(deadLetterKeyPrefix)), nil)
+	defer iter.Release()
+
+	result := make([]*DeadLetter, 0)
+	for iter.Next() {
+		var deadLetter DeadLetter
+		if err := json.Unmarshal(iter.Value(), &deadLetter); err != nil {
+			return nil, err
+		}
+		result = append(result, &deadLetter)
+	}
+
+	if err := iter.Error(); err != nil {
+		return nil, err
+	}
+
+	sort.SliceStable(result, func(i, j int) bool {
+		return result[i].FailedAt.Before(result[j].FailedAt)
+	})
+
+	return result, nil
+}
+
+// Drop removes dead letter for given task uuid
+func (dlq *DeadLetterQueue) Drop(uuid string) error {
+	if _, err := dlq.Get(uuid); err != nil {
+		return err
+	}
+
+	return dlq.db.Delete(deadLetterKey(uuid), nil)
+}
+
+// DeadLetters returns dead-letter queue of the connector
+func (qc *QueueConnector) DeadLetters() *DeadLetterQueue {
+	return qc.stats.deadLetters
+}
+
+// RetryDeadLetter sends dead letter back to queue with fresh retries and removes it from dead-letter queue
+func (qc *QueueConnector) RetryDeadLetter(uuid string) error {
+	deadLetters := qc.DeadLetters()
+
+	deadLetter, err := deadLetters.Get(uuid)
+	if err != nil {
+		return err
+	}
+
+	signature := deadLetter.Signature
+	signature.RetryCount = DefaultRetryCount
+	signature.RetryTimeout = 0
+	signature.ETA = nil
+
+	if _, err := qc.Server.SendTask(signature); err != nil {
+		return err
+	}
+
+	qc.logger.Info("Dead letter sent back to queue", "uuid", uuid, "task", deadLetter.Name)
+	return deadLetters.Drop(uuid)
+}
+
+//
+// utils
+//
+
+func deadLetterKey(uuid string) []byte {
+	return []byte(deadLetterKeyPrefix + uuid)
+}
@@ -0,0 +1,73 @@
+package queue
+
+import (
+	"testing"
+
+	"github.com/RichardKnop/machinery/v1"
+	nullBackend "github.com/RichardKnop/machinery/v1/backends/null"
+	"github.com/RichardKnop/machinery/v1/config"
+	"github.com/RichardKnop/machinery/v1/tasks"
+	"github.com/stretchr/testify/require"
+
+	"github.com/maticnetwork/heimdall/bridge/setu/util"
+)
+
+func TestDeadLetterOnFailure(t *testing.T) {
+	broker := newTestBroker(t)
+	deadLetters := NewDeadLetterQueue(broker.db)
+	stats := newStatsBackend(nullBackend.New(), deadLetters)
+
+	signature := &tasks.Signature{UUID: "task_1", Name: "sendCheckpointToRootchain", RetryCount: 1}
+
+	// first attempt is retried, second one fails
+	require.NoError(t, stats.SetStateStarted(signature))
+	require.NoError(t, stats.SetStateRetry(signature))
+	signature.RetryCount--
+	require.NoError(t, stats.SetStateStarted(signature))
+	require.NoError(t, stats.SetStateFailure(signature, "checkpoint tx failed"))
+
+	deadLetter, err := deadLetters.Get("task_1")
+	require.NoError(t, err)
+	require.Equal(t, "sendCheckpointToRootchain", deadLetter.Name)
+	require.Equal(t, "checkpoint tx failed", deadLetter.LastError)
+	require.Len(t, deadLetter.Attempts, 2)
+	require.Equal(t, "retry", deadLetter.Attempts[0].Status)
+	require.Equal(t, "failure", deadLetter.Attempts[1].Status)
+	require.Empty(t, stats.attempts)
+
+	list, err := deadLetters.List()
+	require.NoError(t, err)
+	require.Len(t, list, 1)
+
+	require.NoError(t, deadLetters.Drop("task_1"))
+	_, err = deadLetters.Get("task_1")
+	require.Equal(t, ErrDeadLetterNotFound, err)
+	require.Equal(t, ErrDeadLetterNotFound, deadLetters.Drop("task_1"))
+}
+
+func TestRetryDeadLetter(t *testing.T) {
+	broker := newTestBroker(t)
+	cnf := &config.Config{DefaultQueue: QueueName}
+	stats := newStatsBackend(nullBackend.New(), NewDeadLetterQueue(broker.db))
+
+	server := machinery.NewServerWithBrokerBackend(cnf, broker, stats)
+	qc := &QueueConnector{logger: util.Logger(), Server: server, stats: stats}
+
+	require.NoError(t, qc.DeadLetters().Put(&DeadLetter{
+		UUID:      "task_1",
+		Name:      "sendCheckpointToRootchain",
+		Signature: &tasks.Signature{UUID: "task_1", Name: "sendCheckpointToRootchain", RetryTimeout: 5},
+	}))
+
+	require.NoError(t, qc.RetryDeadLetter("task_1"))
+
+	pending, err := broker.GetPendingTasks("")
+	require.NoError(t, err)
+	require.Len(t, pending, 1)
+	require.Equal(t, "task_1", pending[0].UUID)
+	require.Equal(t, DefaultRetryCount, pending[0].RetryCount)
+	require.Equal(t, 0, pending[0].RetryTimeout)
+
+	_, err = qc.DeadLetters().Get("task_1")
+	require.Equal(t, ErrDeadLetterNotFound, err)
+}
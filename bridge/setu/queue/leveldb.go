@@ -0,0 +1,364 @@
+package queue
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/RichardKnop/machinery/v1/brokers/iface"
+	"github.com/RichardKnop/machinery/v1/common"
+	"github.com/RichardKnop/machinery/v1/config"
+	"github.com/RichardKnop/machinery/v1/tasks"
+	"github.com/syndtr/goleveldb/leveldb"
+	levelUtil "github.com/syndtr/goleveldb/leveldb/util"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/maticnetwork/heimdall/bridge/setu/util"
+)
+
+const (
+	// LevelDBBrokerPollInterval is the interval at which the broker looks for due tasks
+	LevelDBBrokerPollInterval = 1 * time.Second
+)
+
+var (
+	pendingTaskPrefix  = []byte("queue-pending-")  // queue-pending-<queue>-<time><uuid>
+	delayedTaskPrefix  = []byte("queue-delayed-")  // queue-delayed-<eta><uuid>
+	inflightTaskPrefix = []byte("queue-inflight-") // queue-inflight-<queue>-<time><uuid>
+)
+
+// LevelDBBroker is a machinery broker which keeps tasks in the bridge db.
+// It removes the need of an external message broker for the bridge.
+type LevelDBBroker struct {
+	common.Broker
+	logger log.Logger
+
+	db           *leveldb.DB
+	pollInterval time.Duration
+
+	// claimMutex guards moving tasks between pending, delayed and inflight keys
+	claimMutex   sync.Mutex
+	consumingWG  sync.WaitGroup
+	processingWG sync.WaitGroup
+}
+
+type leveldbDelivery struct {
+	key       []byte
+	signature *tasks.Signature
+}
+
+// NewLevelDBBroker creates new leveldb broker
+func NewLevelDBBroker(cnf *config.Config, db *leveldb.DB) *LevelDBBroker {
+	return &LevelDBBroker{
+		Broker:       common.NewBroker(cnf),
+		logger:       util.Logger().With("module", "LevelDBBroker"),
+		db:           db,
+		pollInterval: LevelDBBrokerPollInterval,
+	}
+}
+
+// StartConsuming enters a loop and waits for due tasks
+func (b *LevelDBBroker) StartConsuming(consumerTag string, concurrency int, taskProcessor iface.TaskProcessor) (bool, error) {
+	b.consumingWG.Add(1)
+	defer b.consumingWG.Done()
+
+	if concurrency < 1 {
+		concurrency = 1
+	}
+
+	b.Broker.StartConsuming(consumerTag, concurrency, taskProcessor)
+
+	// tasks which were being processed when bridge stopped are delivered again
+	if err := b.requeueInflightTasks(); err != nil {
+		return b.GetRetry(), err
+	}
+
+	queue := b.getQueue(taskProcessor)
+	deliveries := make(chan leveldbDelivery)
+	for i := 0; i < concurrency; i++ {
+		b.processingWG.Add(1)
+		go func() {
+			defer b.processingWG.Done()
+			for delivery := range deliveries {
+				b.consumeOne(delivery, taskProcessor)
+			}
+		}()
+	}
+
+	ticker := time.NewTicker(b.pollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-b.GetStopChan():
+			close(deliveries)
+			b.processingWG.Wait()
+			return b.GetRetry(), nil
+		case <-ticker.C:
+			if err := b.promoteDelayedTasks(); err != nil {
+				b.logger.Error("Error while promoting delayed tasks", "error", err)
+			}
+
+			for {
+				delivery, err := b.claimNextTask(queue)
+				if err != nil {
+					b.logger.Error("Error while claiming next task", "queue", queue, "error", err)
+					break
+				}
+				if delivery == nil {
+					break
+				}
+
+				select {
+				case deliveries <- *delivery:
+				case <-b.GetStopChan():
+					close(deliveries)
+					b.processingWG.Wait()
+					return b.GetRetry(), nil
+				}
+			}
+		}
+	}
+}
+
+// StopConsuming quits the loop
+func (b *LevelDBBroker) StopConsuming() {
+	b.Broker.StopConsuming()
+	// Waiting for consumption to finish
+	b.consumingWG.Wait()
+}
+
+// Publish places a new task in the queue (or in delayed tasks if ETA is in the future)
+func (b *LevelDBBroker) Publish(ctx context.Context, signature *tasks.Signature) error {
+	// Adjust routing key (this decides which queue the message will be published to)
+	b.Broker.AdjustRoutingKey(signature)
+
+	msg, err := json.Marshal(signature)
+	if err != nil {
+		return fmt.Errorf("JSON marshal error: %s", err)
+	}
+
+	now := time.Now().UTC()
+	if signature.ETA != nil && signature.ETA.After(now) {
+		return b.db.Put(delayedTaskKey(*signature.ETA, signature.UUID), msg, nil)
+	}
+
+	return b.db.Put(pendingTaskKey(signature.RoutingKey, now, signature.UUID), msg, nil)
+}
+
+// GetPendingTasks returns a slice of task signatures waiting in the queue
+func (b *LevelDBBroker) GetPendingTasks(queue string) ([]*tasks.Signature, error) {
+	if queue == "" {
+		queue = b.GetConfig().DefaultQueue
+	}
+
+	return b.getTasks(pendingQueuePrefix(queue))
+}
+
+// GetDelayedTasks returns a slice of task signatures that are scheduled, but not yet in the queue
+func (b *LevelDBBroker) GetDelayedTasks() ([]*tasks.Signature, error) {
+	return b.getTasks(delayedTaskPrefix)
+}
+
+// Purge removes all pending and delayed tasks
+func (b *LevelDBBroker) Purge() error {
+	b.claimMutex.Lock()
+	defer b.claimMutex.Unlock()
+
+	batch := new(leveldb.Batch)
+	for _, prefix := range [][]byte{pendingTaskPrefix, delayedTaskPrefix} {
+		iter := b.db.NewIterator(levelUtil.BytesPrefix(prefix), nil)
+		for iter.Next() {
+			batch.Delete(copyBytes(iter.Key()))
+		}
+		iter.Release()
+		if err := iter.Error(); err != nil {
+			return err
+		}
+	}
+
+	return b.db.Write(batch, nil)
+}
+
+//
+// internal methods
+//
+
+// consumeOne processes a single task and removes it from inflight tasks
+func (b *LevelDBBroker) consumeOne(delivery leveldbDelivery, taskProcessor iface.TaskProcessor) {
+	// retries are handled by worker. It publishes the task again with new ETA.
+	if err := taskProcessor.Process(delivery.signature); err != nil {
+		b.logger.Error("Error while processing task", "name", delivery.signature.Name, "uuid", delivery.signature.UUID, "error", err)
+	}
+
+	if err := b.db.Delete(delivery.key, nil); err != nil {
+		b.logger.Error("Error while removing processed task", "name", delivery.signature.Name, "uuid", delivery.signature.UUID, "error", err)
+	}
+}
+
+// claimNextTask moves the oldest registered pending task to inflight tasks and returns it
+func (b *LevelDBBroker) claimNextTask(queue string) (*leveldbDelivery, error) {
+	b.claimMutex.Lock()
+	defer b.claimMutex.Unlock()
+
+	prefix := pendingQueuePrefix(queue)
+	iter := b.db.NewIterator(levelUtil.BytesPrefix(prefix), nil)
+	defer iter.Release()
+
+	for iter.Next() {
+		signature, err := decodeSignature(iter.Value())
+		if err != nil {
+			// malformed task would block the queue on every poll
+			b.logger.Error("Dropping malformed pending task", "key", string(iter.Key()), "error", err)
+			if err := b.db.Delete(copyBytes(iter.Key()), nil); err != nil {
+				return nil, err
+			}
+			continue
+		}
+
+		// tasks without registered handler stay in the queue
+		if !b.IsTaskRegistered(signature.Name) {
+			continue
+		}
+
+		key := copyBytes(iter.Key())
+		inflightKey := append(copyBytes(inflightTaskPrefix), key[len(pendingTaskPrefix):]...)
+
+		batch := new(leveldb.Batch)
+		batch.Delete(key)
+		batch.Put(inflightKey, copyBytes(iter.Value()))
+		if err := b.db.Write(batch, nil); err != nil {
+			return nil, err
+		}
+
+		return &leveldbDelivery{key: inflightKey, signature: signature}, nil
+	}
+
+	return nil, iter.Error()
+}
+
+// promoteDelayedTasks moves delayed tasks which are due into their queue
+func (b *LevelDBBroker) promoteDelayedTasks() error {
+	b.claimMutex.Lock()
+	defer b.claimMutex.Unlock()
+
+	now := time.Now().UTC()
+	batch := new(leveldb.Batch)
+
+	iter := b.db.NewIterator(levelUtil.BytesPrefix(delayedTaskPrefix), nil)
+	for iter.Next() {
+		key := iter.Key()
+		eta := int64(binary.BigEndian.Uint64(key[len(delayedTaskPrefix) : len(delayedTaskPrefix)+8]))
+		// keys are sorted by eta
+		if eta > now.UnixNano() {
+			break
+		}
+
+		signature, err := decodeSignature(iter.Value())
+		if err != nil {
+			b.logger.Error("Dropping malformed delayed task", "key", string(key), "error", err)
+			batch.Delete(copyBytes(key))
+			continue
+		}
+
+		batch.Delete(copyBytes(key))
+		batch.Put(pendingTaskKey(signature.RoutingKey, time.Unix(0, eta), signature.UUID), copyBytes(iter.Value()))
+	}
+	iter.Release()
+	if err := iter.Error(); err != nil {
+		return err
+	}
+
+	return b.db.Write(batch, nil)
+}
+
+// requeueInflightTasks moves inflight tasks back to pending tasks
+func (b *LevelDBBroker) requeueInflightTasks() error {
+	b.claimMutex.Lock()
+	defer b.claimMutex.Unlock()
+
+	batch := new(leveldb.Batch)
+	iter := b.db.NewIterator(levelUtil.BytesPrefix(inflightTaskPrefix), nil)
+	for iter.Next() {
+		key := copyBytes(iter.Key())
+		batch.Delete(key)
+		batch.Put(append(copyBytes(pendingTaskPrefix), key[len(inflightTaskPrefix):]...), copyBytes(iter.Value()))
+	}
+	iter.Release()
+	if err := iter.Error(); err != nil {
+		return err
+	}
+
+	if batch.Len() > 0 {
+		b.logger.Info("Requeuing inflight tasks", "count", batch.Len()/2)
+	}
+
+	return b.db.Write(batch, nil)
+}
+
+func (b *LevelDBBroker) getTasks(prefix []byte) ([]*tasks.Signature, error) {
+	iter := b.db.NewIterator(levelUtil.BytesPrefix(prefix), nil)
+	defer iter.Release()
+
+	var result []*tasks.Signature
+	for iter.Next() {
+		signature, err := decodeSignature(iter.Value())
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, signature)
+	}
+
+	return result, iter.Error()
+}
+
+func (b *LevelDBBroker) getQueue(taskProcessor iface.TaskProcessor) string {
+	if customQueue := taskProcessor.CustomQueue(); customQueue != "" {
+		return customQueue
+	}
+	return b.GetConfig().DefaultQueue
+}
+
+//
+// utils
+//
+
+func pendingQueuePrefix(queue string) []byte {
+	prefix := append(copyBytes(pendingTaskPrefix), []byte(queue)...)
+	return append(prefix, '-')
+}
+
+func pendingTaskKey(queue string, t time.Time, uuid string) []byte {
+	return append(append(pendingQueuePrefix(queue), timeBytes(t)...), []byte(uuid)...)
+}
+
+func delayedTaskKey(eta time.Time, uuid string) []byte {
+	return append(append(copyBytes(delayedTaskPrefix), timeBytes(eta)...), []byte(uuid)...)
+}
+
+// timeBytes returns big endian unix nano time, so that keys are sorted by time
+func timeBytes(t time.Time) []byte {
+	result := make([]byte, 8)
+	binary.BigEndian.PutUint64(result, uint64(t.UnixNano()))
+	return result
+}
+
+func decodeSignature(data []byte) (*tasks.Signature, error) {
+	signature := new(tasks.Signature)
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	if err := decoder.Decode(signature); err != nil {
+		return nil, err
+	}
+	return signature, nil
+}
+
+func copyBytes(data []byte) []byte {
+	result := make([]byte, len(data))
+	copy(result, data)
+	return result
+}
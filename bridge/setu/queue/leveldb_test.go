@@ -0,0 +1,118 @@
+package queue
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/RichardKnop/machinery/v1/config"
+	"github.com/RichardKnop/machinery/v1/tasks"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+	"github.com/syndtr/goleveldb/leveldb"
+	"github.com/syndtr/goleveldb/leveldb/storage"
+)
+
+type testProcessor struct {
+	processed chan *tasks.Signature
+}
+
+func (tp *testProcessor) Process(signature *tasks.Signature) error {
+	tp.processed <- signature
+	return nil
+}
+
+func (tp *testProcessor) CustomQueue() string {
+	return ""
+}
+
+func newTestBroker(t *testing.T) *LevelDBBroker {
+	viper.Set("log_level", "info")
+
+	db, err := leveldb.Open(storage.NewMemStorage(), nil)
+	require.NoError(t, err)
+
+	broker := NewLevelDBBroker(&config.Config{DefaultQueue: QueueName}, db)
+	broker.pollInterval = 10 * time.Millisecond
+	broker.SetRegisteredTaskNames([]string{"sendCheckpointToRootchain"})
+	return broker
+}
+
+func TestLevelDBBrokerPublish(t *testing.T) {
+	broker := newTestBroker(t)
+
+	eta := time.Now().Add(time.Hour)
+	require.NoError(t, broker.Publish(context.Background(), &tasks.Signature{UUID: "task_1", Name: "sendCheckpointToRootchain"}))
+	require.NoError(t, broker.Publish(context.Background(), &tasks.Signature{UUID: "task_2", Name: "sendCheckpointToRootchain", ETA: &eta}))
+
+	pending, err := broker.GetPendingTasks("")
+	require.NoError(t, err)
+	require.Len(t, pending, 1)
+	require.Equal(t, "task_1", pending[0].UUID)
+	require.Equal(t, QueueName, pending[0].RoutingKey)
+
+	delayed, err := broker.GetDelayedTasks()
+	require.NoError(t, err)
+	require.Len(t, delayed, 1)
+	require.Equal(t, "task_2", delayed[0].UUID)
+
+	require.NoError(t, broker.Purge())
+
+	pending, err = broker.GetPendingTasks("")
+	require.NoError(t, err)
+	require.Empty(t, pending)
+
+	delayed, err = broker.GetDelayedTasks()
+	require.NoError(t, err)
+	require.Empty(t, delayed)
+}
+
+func TestLevelDBBrokerConsume(t *testing.T) {
+	broker := newTestBroker(t)
+	processor := &testProcessor{processed: make(chan *tasks.Signature, 10)}
+
+	eta := time.Now().Add(50 * time.Millisecond)
+	require.NoError(t, broker.Publish(context.Background(), &tasks.Signature{UUID: "task_1", Name: "sendCheckpointToRootchain", ETA: &eta}))
+	require.NoError(t, broker.Publish(context.Background(), &tasks.Signature{UUID: "task_2", Name: "sendCheckpointToRootchain"}))
+	require.NoError(t, broker.Publish(context.Background(), &tasks.Signature{UUID: "task_3", Name: "unregisteredTask"}))
+
+	go func() {
+		_, _ = broker.StartConsuming("test", 1, processor)
+	}()
+
+	// immediate task first, delayed task after its eta
+	for _, uuid := range []string{"task_2", "task_1"} {
+		select {
+		case signature := <-processor.processed:
+			require.Equal(t, uuid, signature.UUID)
+		case <-time.After(5 * time.Second):
+			t.Fatalf("timed out waiting for %v", uuid)
+		}
+	}
+
+	broker.StopConsuming()
+
+	// unregistered task stays in the queue
+	pending, err := broker.GetPendingTasks("")
+	require.NoError(t, err)
+	require.Len(t, pending, 1)
+	require.Equal(t, "task_3", pending[0].UUID)
+}
+
+func TestLevelDBBrokerSkipsMalformedTask(t *testing.T) {
+	broker := newTestBroker(t)
+
+	// malformed task is older than valid one
+	malformedKey := pendingTaskKey(QueueName, time.Now().Add(-time.Minute), "malformed")
+	require.NoError(t, broker.db.Put(malformedKey, []byte("not-a-signature"), nil))
+	require.NoError(t, broker.Publish(context.Background(), &tasks.Signature{UUID: "task_1", Name: "sendCheckpointToRootchain"}))
+
+	delivery, err := broker.claimNextTask(QueueName)
+	require.NoError(t, err)
+	require.NotNil(t, delivery)
+	require.Equal(t, "task_1", delivery.signature.UUID)
+
+	has, err := broker.db.Has(malformedKey, nil)
+	require.NoError(t, err)
+	require.False(t, has, "malformed task should be removed from queue")
+}
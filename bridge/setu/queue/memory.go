@@ -0,0 +1,36 @@
+package queue
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	eagerBroker "github.com/RichardKnop/machinery/v1/brokers/eager"
+	"github.com/RichardKnop/machinery/v1/tasks"
+)
+
+// ErrDelayedTask is returned by memory broker for tasks scheduled in future (e.g. retries)
+var ErrDelayedTask = errors.New("Delayed tasks are not supported by memory queue")
+
+// MemoryBroker is a machinery broker which processes tasks in-process while they are sent.
+// It is meant for tests, where the result of a task is expected as soon as SendTask returns.
+//
+// Delayed tasks are rejected with ErrDelayedTask instead of being processed immediately,
+// otherwise a task retrying itself would never return.
+type MemoryBroker struct {
+	*eagerBroker.Broker
+}
+
+// NewMemoryBroker creates new memory broker
+func NewMemoryBroker() *MemoryBroker {
+	return &MemoryBroker{Broker: eagerBroker.New().(*eagerBroker.Broker)}
+}
+
+// Publish processes the task, delayed tasks are rejected
+func (b *MemoryBroker) Publish(ctx context.Context, signature *tasks.Signature) error {
+	if signature.ETA != nil && signature.ETA.After(time.Now().UTC()) {
+		return ErrDelayedTask
+	}
+
+	return b.Broker.Publish(ctx, signature)
+}
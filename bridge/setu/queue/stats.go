@@ -0,0 +1,166 @@
+package queue
+
+import (
+	"sync"
+	"time"
+
+	backendsIface "github.com/RichardKnop/machinery/v1/backends/iface"
+	"github.com/RichardKnop/machinery/v1/tasks"
+
+	"github.com/maticnetwork/heimdall/bridge/setu/metrics"
+)
+
+// TaskStats represents number of queued, delayed and failed tasks for a task name
+type TaskStats struct {
+	Queued  uint64 `json:"queued"`
+	Delayed uint64 `json:"delayed"`
+	Failed  uint64 `json:"failed"`
+}
+
+// statsBackend wraps result backend to count sent and failed tasks, to measure processor latency
+// and to move tasks with no retries left to dead-letter queue
+type statsBackend struct {
+	backendsIface.Backend
+
+	mutex    sync.RWMutex
+	failed   map[string]uint64
+	attempts map[string][]TaskAttempt // task uuid => attempts, last one is in progress
+
+	deadLetters *DeadLetterQueue
+}
+
+func newStatsBackend(backend backendsIface.Backend, deadLetters *DeadLetterQueue) *statsBackend {
+	return &statsBackend{
+		Backend:     backend,
+		failed:      make(map[string]uint64),
+		attempts:    make(map[string][]TaskAttempt),
+		deadLetters: deadLetters,
+	}
+}
+
+// SetStatePending is called by server when task is sent
+func (b *statsBackend) SetStatePending(signature *tasks.Signature) error {
+	metrics.TasksSent.WithLabelValues(signature.Name).Inc()
+
+	return b.Backend.SetStatePending(signature)
+}
+
+// SetStateStarted is called by worker right before task handler is called
+func (b *statsBackend) SetStateStarted(signature *tasks.Signature) error {
+	b.mutex.Lock()
+	b.attempts[signature.UUID] = append(b.attempts[signature.UUID], TaskAttempt{StartedAt: time.Now().UTC()})
+	b.mutex.Unlock()
+
+	return b.Backend.SetStateStarted(signature)
+}
+
+// SetStateRetry is called by worker when task handler returns error and task has retries left
+func (b *statsBackend) SetStateRetry(signature *tasks.Signature) error {
+	b.finishAttempt(signature, "retry")
+
+	return b.Backend.SetStateRetry(signature)
+}
+
+// SetStateSuccess is called by worker when task handler succeeds
+func (b *statsBackend) SetStateSuccess(signature *tasks.Signature, results []*tasks.TaskResult) error {
+	b.finishAttempt(signature, "success")
+
+	b.mutex.Lock()
+	delete(b.attempts, signature.UUID)
+	b.mutex.Unlock()
+
+	return b.Backend.SetStateSuccess(signature, results)
+}
+
+// SetStateFailure is called by worker once task has no retries left
+func (b *statsBackend) SetStateFailure(signature *tasks.Signature, err string) error {
+	b.finishAttempt(signature, "failure")
+	metrics.TasksFailed.WithLabelValues(signature.Name).Inc()
+
+	b.mutex.Lock()
+	b.failed[signature.Name]++
+	attempts := b.attempts[signature.UUID]
+	delete(b.attempts, signature.UUID)
+	b.mutex.Unlock()
+
+	if b.deadLetters != nil {
+		deadLetter := &DeadLetter{
+			UUID:      signature.UUID,
+			Name:      signature.Name,
+			Signature: signature,
+			LastError: err,
+			Attempts:  attempts,
+			FailedAt:  time.Now().UTC(),
+		}
+		if dlqErr := b.deadLetters.Put(deadLetter); dlqErr != nil {
+			return dlqErr
+		}
+	}
+
+	return b.Backend.SetStateFailure(signature, err)
+}
+
+// finishAttempt closes current attempt of the task and records time taken by it
+func (b *statsBackend) finishAttempt(signature *tasks.Signature, status string) {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	attempts := b.attempts[signature.UUID]
+	if len(attempts) == 0 || !attempts[len(attempts)-1].FinishedAt.IsZero() {
+		return
+	}
+
+	attempt := &attempts[len(attempts)-1]
+	attempt.FinishedAt = time.Now().UTC()
+	attempt.Status = status
+
+	metrics.ProcessorLatency.WithLabelValues(signature.Name, status).Observe(attempt.FinishedAt.Sub(attempt.StartedAt).Seconds())
+}
+
+// failedTasks returns copy of failed task counts
+func (b *statsBackend) failedTasks() map[string]uint64 {
+	b.mutex.RLock()
+	defer b.mutex.RUnlock()
+
+	result := make(map[string]uint64, len(b.failed))
+	for name, count := range b.failed {
+		result[name] = count
+	}
+	return result
+}
+
+// TaskStats returns queued, delayed and failed tasks per task name.
+// Delayed tasks are only known for leveldb backend, amqp keeps them in temporary delay queues.
+func (qc *QueueConnector) TaskStats() (map[string]*TaskStats, error) {
+	result := make(map[string]*TaskStats)
+	get := func(name string) *TaskStats {
+		if _, ok := result[name]; !ok {
+			result[name] = &TaskStats{}
+		}
+		return result[name]
+	}
+
+	pending, err := qc.Server.GetBroker().GetPendingTasks(QueueName)
+	if err != nil {
+		return nil, err
+	}
+	for _, signature := range pending {
+		get(signature.Name).Queued++
+	}
+
+	if broker, ok := qc.Server.GetBroker().(*LevelDBBroker); ok {
+		delayed, err := broker.GetDelayedTasks()
+		if err != nil {
+			return nil, err
+		}
+		for _, signature := range delayed {
+			get(signature.Name).Delayed++
+		}
+	}
+
+	for name, count := range qc.stats.failedTasks() {
+		get(name).Failed = count
+	}
+
+	return result, nil
+}
@@ -0,0 +1,270 @@
+package supervisor
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/maticnetwork/heimdall/bridge/setu/metrics"
+)
+
+const (
+	// DefaultMinBackoff is the delay before first restart of a failed component
+	DefaultMinBackoff = 1 * time.Second
+	// DefaultMaxBackoff is the max delay between restarts of a failed component
+	DefaultMaxBackoff = 2 * time.Minute
+)
+
+// Component is a bridge listener or processor run by supervisor
+type Component interface {
+	Start() error
+
+	Stop()
+
+	String() string
+}
+
+// FailureReporter is implemented by components which can fail after they are started,
+// eg. listener whose subscription died
+type FailureReporter interface {
+	Failed() <-chan error
+}
+
+// State represents state of a supervised component
+type State string
+
+const (
+	// StateStarting component is being started
+	StateStarting State = "starting"
+	// StateRunning component is started and has not failed since
+	StateRunning State = "running"
+	// StateRestarting component failed and waits to be started again
+	StateRestarting State = "restarting"
+	// StateStopped component is stopped with supervisor
+	StateStopped State = "stopped"
+)
+
+// Health represents health of a supervised component
+type Health struct {
+	Name      string    `json:"name"`
+	Kind      string    `json:"kind"`
+	State     State     `json:"state"`
+	Restarts  uint64    `json:"restarts"`
+	LastError string    `json:"last_error,omitempty"`
+	StartedAt time.Time `json:"started_at"`
+	FailedAt  time.Time `json:"failed_at"`
+}
+
+// supervised is a component with its health
+type supervised struct {
+	component Component
+	health    Health
+}
+
+// Supervisor starts components and restarts them with exponential backoff when they fail
+type Supervisor struct {
+	logger     log.Logger
+	minBackoff time.Duration
+	maxBackoff time.Duration
+
+	mutex      sync.RWMutex
+	components []*supervised
+
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+// NewSupervisor creates new supervisor
+func NewSupervisor(logger log.Logger, minBackoff time.Duration, maxBackoff time.Duration) *Supervisor {
+	return &Supervisor{
+		logger:     logger,
+		minBackoff: minBackoff,
+		maxBackoff: maxBackoff,
+	}
+}
+
+// Add adds component of given kind (listener, processor). Must be called before supervisor starts.
+func (s *Supervisor) Add(kind string, component Component) {
+	s.components = append(s.components, &supervised{
+		component: component,
+		health: Health{
+			Name:  component.String(),
+			Kind:  kind,
+			State: StateStopped,
+		},
+	})
+}
+
+// Start starts all components, each one is restarted independently when it fails
+func (s *Supervisor) Start() {
+	ctx, cancel := context.WithCancel(context.Background())
+	s.cancel = cancel
+
+	for _, c := range s.components {
+		s.wg.Add(1)
+		go func(c *supervised) {
+			defer s.wg.Done()
+			s.run(ctx, c)
+		}(c)
+	}
+}
+
+// Stop stops restarting components and stops all of them
+func (s *Supervisor) Stop() {
+	if s.cancel != nil {
+		s.cancel()
+	}
+	s.wg.Wait()
+
+	for _, c := range s.components {
+		if s.state(c) == StateRunning {
+			s.stopComponent(c)
+		}
+		s.setState(c, StateStopped)
+	}
+}
+
+// Health returns health of all components
+func (s *Supervisor) Health() []Health {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	result := make([]Health, 0, len(s.components))
+	for _, c := range s.components {
+		result = append(result, c.health)
+	}
+	return result
+}
+
+// Healthy returns true if all components are running
+func (s *Supervisor) Healthy() bool {
+	for _, health := range s.Health() {
+		if health.State != StateRunning {
+			return false
+		}
+	}
+	return true
+}
+
+// run starts component and restarts it until supervisor is stopped
+func (s *Supervisor) run(ctx context.Context, c *supervised) {
+	logger := s.logger.With("kind", c.health.Kind, "component", c.health.Name)
+	backoff := s.minBackoff
+
+	for {
+		s.setState(c, StateStarting)
+		err := s.startComponent(c)
+		if err == nil {
+			s.setState(c, StateRunning)
+			logger.Info("Component started")
+
+			select {
+			case err = <-failures(c.component):
+			case <-ctx.Done():
+				return
+			}
+
+			// component was stable for a while, start again with min backoff
+			if time.Since(s.startedAt(c)) > s.maxBackoff {
+				backoff = s.minBackoff
+			}
+
+			s.stopComponent(c)
+		}
+
+		s.setFailed(c, err)
+		logger.Error("Component failed, restarting", "error", err, "backoff", backoff)
+
+		select {
+		case <-time.After(backoff):
+		case <-ctx.Done():
+			return
+		}
+
+		if backoff *= 2; backoff > s.maxBackoff {
+			backoff = s.maxBackoff
+		}
+	}
+}
+
+// startComponent starts component, panics are returned as error
+func (s *Supervisor) startComponent(c *supervised) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("panic while starting: %v", r)
+		}
+	}()
+
+	return c.component.Start()
+}
+
+// stopComponent stops component, panics are logged
+func (s *Supervisor) stopComponent(c *supervised) {
+	defer func() {
+		if r := recover(); r != nil {
+			s.logger.Error("Panic while stopping component", "component", c.health.Name, "error", r)
+		}
+	}()
+
+	c.component.Stop()
+}
+
+// failures returns channel on which component reports failures, nil if it doesn't
+func failures(component Component) <-chan error {
+	if reporter, ok := component.(FailureReporter); ok {
+		return reporter.Failed()
+	}
+	return nil
+}
+
+//
+// health
+//
+
+func (s *Supervisor) state(c *supervised) State {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	return c.health.State
+}
+
+func (s *Supervisor) startedAt(c *supervised) time.Time {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	return c.health.StartedAt
+}
+
+func (s *Supervisor) setState(c *supervised, state State) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	c.health.State = state
+	if state == StateRunning {
+		c.health.StartedAt = time.Now().UTC()
+	}
+
+	up := float64(0)
+	if state == StateRunning {
+		up = 1
+	}
+	metrics.ComponentUp.WithLabelValues(c.health.Kind, c.health.Name).Set(up)
+}
+
+func (s *Supervisor) setFailed(c *supervised, err error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	c.health.State = StateRestarting
+	c.health.Restarts++
+	c.health.FailedAt = time.Now().UTC()
+	if err != nil {
+		c.health.LastError = err.Error()
+	}
+
+	metrics.ComponentUp.WithLabelValues(c.health.Kind, c.health.Name).Set(0)
+	metrics.ComponentRestarts.WithLabelValues(c.health.Kind, c.health.Name).Inc()
+}
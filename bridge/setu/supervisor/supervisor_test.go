@@ -0,0 +1,118 @@
+package supervisor
+
+import (
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/log"
+)
+
+type testComponent struct {
+	mutex      sync.Mutex
+	startErrs  []error // returned by subsequent starts
+	starts     int
+	stops      int
+	failed     chan error
+	panicStart bool
+}
+
+func newTestComponent(startErrs ...error) *testComponent {
+	return &testComponent{startErrs: startErrs, failed: make(chan error, 1)}
+}
+
+func (c *testComponent) Start() error {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	c.starts++
+	if c.panicStart {
+		c.panicStart = false
+		panic("boom")
+	}
+	if len(c.startErrs) > 0 {
+		err := c.startErrs[0]
+		c.startErrs = c.startErrs[1:]
+		return err
+	}
+	return nil
+}
+
+func (c *testComponent) Stop() {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	c.stops++
+}
+
+func (c *testComponent) String() string {
+	return "test"
+}
+
+func (c *testComponent) Failed() <-chan error {
+	return c.failed
+}
+
+func (c *testComponent) counts() (int, int) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	return c.starts, c.stops
+}
+
+func waitForState(t *testing.T, s *Supervisor, state State, restarts uint64) Health {
+	var health Health
+	require.Eventually(t, func() bool {
+		health = s.Health()[0]
+		return health.State == state && health.Restarts == restarts
+	}, time.Second, time.Millisecond)
+	return health
+}
+
+func TestRestartOnStartError(t *testing.T) {
+	component := newTestComponent(errors.New("rpc unavailable"), nil)
+	component.panicStart = true
+
+	s := NewSupervisor(log.NewNopLogger(), time.Millisecond, 10*time.Millisecond)
+	s.Add("listener", component)
+	s.Start()
+
+	// panic and error are both restarted
+	health := waitForState(t, s, StateRunning, 2)
+	require.Equal(t, "rpc unavailable", health.LastError)
+	require.Equal(t, "listener", health.Kind)
+	require.True(t, s.Healthy())
+
+	starts, stops := component.counts()
+	require.Equal(t, 3, starts)
+	require.Equal(t, 0, stops)
+
+	s.Stop()
+	require.Equal(t, StateStopped, s.Health()[0].State)
+	require.False(t, s.Healthy())
+
+	_, stops = component.counts()
+	require.Equal(t, 1, stops)
+}
+
+func TestRestartOnReportedFailure(t *testing.T) {
+	component := newTestComponent()
+
+	s := NewSupervisor(log.NewNopLogger(), time.Millisecond, 10*time.Millisecond)
+	s.Add("listener", component)
+	s.Start()
+	defer s.Stop()
+
+	waitForState(t, s, StateRunning, 0)
+
+	// failed component is stopped and started again
+	component.failed <- errors.New("subscription failed")
+	health := waitForState(t, s, StateRunning, 1)
+	require.Equal(t, "subscription failed", health.LastError)
+
+	starts, stops := component.counts()
+	require.Equal(t, 2, starts)
+	require.Equal(t, 1, stops)
+}
@@ -32,12 +32,15 @@ const (
 	AccountDetailsURL       = "/auth/accounts/%v"
 	LastNoAckURL            = "/checkpoints/last-no-ack"
 	CheckpointParamsURL     = "/checkpoints/params"
+	CheckpointLengthURL     = "/checkpoints/length"
 	ChainManagerParamsURL   = "/chainmanager/params"
 	ProposersURL            = "/staking/proposer/%v"
 	BufferedCheckpointURL   = "/checkpoints/buffer"
 	LatestCheckpointURL     = "/checkpoints/latest"
+	CheckpointURL           = "/checkpoints/%v"
 	CurrentProposerURL      = "/staking/current-proposer"
 	LatestSpanURL           = "/bor/latest-span"
+	SpanURL                 = "/bor/span/%v"
 	NextSpanInfoURL         = "/bor/prepare-next-span"
 	NextSpanSeedURL         = "/bor/next-span-seed"
 	DividendAccountRootURL  = "/topup/dividend-account-root"
@@ -133,39 +136,6 @@ func IsInProposerList(cliCtx cliContext.CLIContext, count uint64) (bool, error)
 	return false, nil
 }
 
-// CalculateTaskDelay calculates delay required for current validator to propose the tx
-// It solves for multiple validators sending same transaction.
-func CalculateTaskDelay(cliCtx cliContext.CLIContext) (bool, time.Duration) {
-	// calculate validator position
-	valPosition := 0
-	isCurrentValidator := false
-	response, err := helper.FetchFromAPI(cliCtx, helper.GetHeimdallServerEndpoint(CurrentValidatorSetURL))
-	if err != nil {
-		logger.Error("Unable to send request for current validatorset", "url", CurrentValidatorSetURL, "error", err)
-		return isCurrentValidator, 0
-	}
-	// unmarshall data from buffer
-	var validatorSet hmtypes.ValidatorSet
-	err = json.Unmarshal(response.Result, &validatorSet)
-	if err != nil {
-		logger.Error("Error unmarshalling current validatorset data ", "error", err)
-		return isCurrentValidator, 0
-	}
-
-	logger.Info("Fetched current validatorset list", "currentValidatorcount", len(validatorSet.Validators))
-	for i, validator := range validatorSet.Validators {
-		if bytes.Equal(validator.Signer.Bytes(), helper.GetAddress()) {
-			valPosition = i + 1
-			isCurrentValidator = true
-			break
-		}
-	}
-
-	// calculate delay
-	taskDelay := time.Duration(valPosition) * TaskDelayBetweenEachVal
-	return isCurrentValidator, taskDelay
-}
-
 // IsCurrentProposer checks if we are current proposer
 func IsCurrentProposer(cliCtx cliContext.CLIContext) (bool, error) {
 	var proposer hmtypes.Validator
@@ -331,6 +301,27 @@ func GetCheckpointParams(cliCtx cliContext.CLIContext) (*checkpointTypes.Params,
 	return &params, nil
 }
 
+// GetCheckpointLength return length of next checkpoint
+func GetCheckpointLength(cliCtx cliContext.CLIContext) (*checkpointTypes.CheckpointLength, error) {
+	response, err := helper.FetchFromAPI(
+		cliCtx,
+		helper.GetHeimdallServerEndpoint(CheckpointLengthURL),
+	)
+
+	if err != nil {
+		logger.Error("Error fetching checkpoint length", "err", err)
+		return nil, err
+	}
+
+	var length checkpointTypes.CheckpointLength
+	if err := json.Unmarshal(response.Result, &length); err != nil {
+		logger.Error("Error unmarshalling checkpoint length", "url", CheckpointLengthURL, "err", err)
+		return nil, err
+	}
+
+	return &length, nil
+}
+
 // GetBufferedCheckpoint return checkpoint from bueffer
 func GetBufferedCheckpoint(cliCtx cliContext.CLIContext) (*hmtypes.Checkpoint, error) {
 	response, err := helper.FetchFromAPI(
@@ -382,3 +373,17 @@ func AppendPrefix(signerPubKey []byte) []byte {
 	signerPubKey = append(prefix[:], signerPubKey[:]...)
 	return signerPubKey
 }
+
+// IsServiceSelected returns true if bridge is started with --all or service is in --only list
+func IsServiceSelected(name string) bool {
+	if viper.GetBool("all") {
+		return true
+	}
+
+	for _, service := range viper.GetStringSlice("only") {
+		if service == name {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,49 @@
+package util
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/syndtr/goleveldb/leveldb"
+)
+
+const (
+	idempotencyKeyPrefix  = "idempotency-" // idempotency-<chain>-<txHash>-<logIndex>
+	idempotencyChainRoot  = "rootchain"
+	idempotencyKeyPattern = idempotencyKeyPrefix + "%v-%v-%v"
+)
+
+// IdempotencyStore records events which are already processed on heimdall along with heimdall tx hash
+type IdempotencyStore struct {
+	db *leveldb.DB
+}
+
+// NewIdempotencyStore creates idempotency store on given db
+func NewIdempotencyStore(db *leveldb.DB) *IdempotencyStore {
+	return &IdempotencyStore{db: db}
+}
+
+// MarkRootchainEvent records rootchain event (tx hash, log index) as processed by heimdall tx
+func (s *IdempotencyStore) MarkRootchainEvent(txHash string, logIndex uint64, heimdallTxHash string) error {
+	return s.db.Put(idempotencyKey(idempotencyChainRoot, txHash, logIndex), []byte(heimdallTxHash), nil)
+}
+
+// GetRootchainEvent returns heimdall tx hash which processed rootchain event
+func (s *IdempotencyStore) GetRootchainEvent(txHash string, logIndex uint64) (string, bool, error) {
+	value, err := s.db.Get(idempotencyKey(idempotencyChainRoot, txHash, logIndex), nil)
+	if err == leveldb.ErrNotFound {
+		return "", false, nil
+	} else if err != nil {
+		return "", false, err
+	}
+
+	return string(value), true, nil
+}
+
+//
+// utils
+//
+
+func idempotencyKey(chain string, txHash string, logIndex uint64) []byte {
+	return []byte(fmt.Sprintf(idempotencyKeyPattern, chain, strings.ToLower(txHash), logIndex))
+}
@@ -0,0 +1,32 @@
+package util
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/syndtr/goleveldb/leveldb"
+	"github.com/syndtr/goleveldb/leveldb/storage"
+)
+
+func TestIdempotencyStore(t *testing.T) {
+	db, err := leveldb.Open(storage.NewMemStorage(), nil)
+	require.NoError(t, err)
+
+	store := NewIdempotencyStore(db)
+
+	_, ok, err := store.GetRootchainEvent("0xABCD", 1)
+	require.NoError(t, err)
+	require.False(t, ok)
+
+	require.NoError(t, store.MarkRootchainEvent("0xABCD", 1, "0x1234"))
+
+	// tx hash is case insensitive
+	heimdallTxHash, ok, err := store.GetRootchainEvent("0xabcd", 1)
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Equal(t, "0x1234", heimdallTxHash)
+
+	_, ok, err = store.GetRootchainEvent("0xabcd", 2)
+	require.NoError(t, err)
+	require.False(t, ok)
+}
@@ -0,0 +1,100 @@
+package util
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"sort"
+	"time"
+
+	cliContext "github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/maticnetwork/bor/common"
+	"github.com/maticnetwork/bor/crypto"
+
+	"github.com/maticnetwork/heimdall/helper"
+	hmtypes "github.com/maticnetwork/heimdall/types"
+)
+
+// GetTaskOwners orders validators for an event identified by tx hash and log index.
+// First validator is the primary owner of the event, rest are ordered backups.
+// Order is derived from event and validator signer only, so all validators compute the same order.
+func GetTaskOwners(validators []*hmtypes.Validator, txHash common.Hash, logIndex uint64) []*hmtypes.Validator {
+	type rankedValidator struct {
+		validator *hmtypes.Validator
+		rank      []byte
+	}
+
+	logIndexBytes := make([]byte, 8)
+	binary.BigEndian.PutUint64(logIndexBytes, logIndex)
+
+	ranked := make([]rankedValidator, 0, len(validators))
+	for _, validator := range validators {
+		ranked = append(ranked, rankedValidator{
+			validator: validator,
+			rank:      crypto.Keccak256(txHash.Bytes(), logIndexBytes, validator.Signer.Bytes()),
+		})
+	}
+
+	sort.SliceStable(ranked, func(i, j int) bool {
+		return bytes.Compare(ranked[i].rank, ranked[j].rank) < 0
+	})
+
+	owners := make([]*hmtypes.Validator, 0, len(ranked))
+	for _, r := range ranked {
+		owners = append(owners, r.validator)
+	}
+
+	return owners
+}
+
+// GetTaskOwnerSlot returns position of signer in owners of an event (0 for primary), -1 if signer is not a validator
+func GetTaskOwnerSlot(validators []*hmtypes.Validator, txHash common.Hash, logIndex uint64, signer []byte) int {
+	for i, validator := range GetTaskOwners(validators, txHash, logIndex) {
+		if bytes.Equal(validator.Signer.Bytes(), signer) {
+			return i
+		}
+	}
+
+	return -1
+}
+
+// GetCurrentValidators returns validators of current validator set.
+// Listeners fetch it once per queried window and share it across events of the window.
+func GetCurrentValidators(cliCtx cliContext.CLIContext) ([]*hmtypes.Validator, error) {
+	response, err := helper.FetchFromAPI(cliCtx, helper.GetHeimdallServerEndpoint(CurrentValidatorSetURL))
+	if err != nil {
+		logger.Error("Unable to send request for current validatorset", "url", CurrentValidatorSetURL, "error", err)
+		return nil, err
+	}
+
+	var validatorSet hmtypes.ValidatorSet
+	if err := json.Unmarshal(response.Result, &validatorSet); err != nil {
+		logger.Error("Error unmarshalling current validatorset data ", "error", err)
+		return nil, err
+	}
+
+	return validatorSet.Validators, nil
+}
+
+// CalculateTaskOwnership returns if current validator owns the event and delay after which it should submit it.
+// Primary submits right away, backup at slot n submits after n * TaskDelayBetweenEachVal,
+// processors skip the task if event is already seen on heimdall by then.
+// Every validator is a backup, so event is submitted as long as any validator is online.
+func CalculateTaskOwnership(validators []*hmtypes.Validator, txHash common.Hash, logIndex uint64) (bool, time.Duration) {
+	isOwner, delay := getTaskOwnership(validators, txHash, logIndex, helper.GetAddress())
+	if isOwner {
+		logger.Debug("Calculated task ownership", "txHash", txHash.Hex(), "logIndex", logIndex, "delay", delay, "validators", len(validators))
+	}
+
+	return isOwner, delay
+}
+
+// getTaskOwnership returns if signer owns the event and its delay by slot of signer in owners of the event
+func getTaskOwnership(validators []*hmtypes.Validator, txHash common.Hash, logIndex uint64, signer []byte) (bool, time.Duration) {
+	slot := GetTaskOwnerSlot(validators, txHash, logIndex, signer)
+	if slot < 0 {
+		return false, 0
+	}
+
+	return true, time.Duration(slot) * TaskDelayBetweenEachVal
+}
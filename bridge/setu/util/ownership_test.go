@@ -0,0 +1,70 @@
+package util
+
+import (
+	"testing"
+	"time"
+
+	"github.com/maticnetwork/bor/common"
+	"github.com/stretchr/testify/require"
+
+	hmtypes "github.com/maticnetwork/heimdall/types"
+)
+
+func testValidators(count int) []*hmtypes.Validator {
+	validators := make([]*hmtypes.Validator, 0, count)
+	for i := 1; i <= count; i++ {
+		validators = append(validators, &hmtypes.Validator{
+			ID:     hmtypes.NewValidatorID(uint64(i)),
+			Signer: hmtypes.BytesToHeimdallAddress([]byte{byte(i)}),
+		})
+	}
+	return validators
+}
+
+func TestGetTaskOwnersDeterministic(t *testing.T) {
+	validators := testValidators(5)
+	txHash := common.HexToHash("0x1")
+
+	owners := GetTaskOwners(validators, txHash, 0)
+	require.Len(t, owners, len(validators))
+
+	// same order regardless of validator set order
+	reversed := make([]*hmtypes.Validator, len(validators))
+	for i, validator := range validators {
+		reversed[len(validators)-1-i] = validator
+	}
+	require.Equal(t, owners, GetTaskOwners(reversed, txHash, 0))
+
+	for slot, owner := range owners {
+		require.Equal(t, slot, GetTaskOwnerSlot(validators, txHash, 0, owner.Signer.Bytes()))
+	}
+	require.Equal(t, -1, GetTaskOwnerSlot(validators, txHash, 0, []byte{0xff}))
+}
+
+func TestGetTaskOwnersSpreadsPrimary(t *testing.T) {
+	validators := testValidators(4)
+
+	primaries := make(map[hmtypes.ValidatorID]bool)
+	for i := uint64(0); i < 100; i++ {
+		primaries[GetTaskOwners(validators, common.HexToHash("0x1"), i)[0].ID] = true
+	}
+
+	// every validator is primary for some events
+	require.Len(t, primaries, len(validators))
+}
+
+func TestTaskOwnershipWithAbsentOwners(t *testing.T) {
+	validators := testValidators(7)
+	txHash := common.HexToHash("0x2")
+	owners := GetTaskOwners(validators, txHash, 3)
+
+	// primary and first 3 backups are offline, later backups still own the event
+	for slot := 4; slot < len(owners); slot++ {
+		isOwner, delay := getTaskOwnership(validators, txHash, 3, owners[slot].Signer.Bytes())
+		require.True(t, isOwner)
+		require.Equal(t, time.Duration(slot)*TaskDelayBetweenEachVal, delay)
+	}
+
+	isOwner, _ := getTaskOwnership(validators, txHash, 3, []byte{0xff})
+	require.False(t, isOwner)
+}
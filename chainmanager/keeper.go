@@ -57,6 +57,38 @@ func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
 
 // GetParams gets the chainmanager module's parameters.
 func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
-	k.paramSpace.GetParamSet(ctx, &params)
+	// params added after genesis keep defaults until set by governance or upgrade
+	params = types.DefaultParams()
+	for _, pair := range params.ParamSetPairs() {
+		k.paramSpace.GetIfExists(ctx, pair.Key, pair.Value)
+	}
 	return
 }
+
+// MigrateParams sets params which are missing in store (added after genesis) to their defaults.
+// It runs once at upgrade height of the chain, InitGenesis sets all params on new chains.
+func (k Keeper) MigrateParams(ctx sdk.Context) {
+	params := types.DefaultParams()
+	for _, pair := range params.ParamSetPairs() {
+		if !k.paramSpace.Has(ctx, pair.Key) {
+			k.paramSpace.Set(ctx, pair.Key, pair.Value)
+		}
+	}
+}
+
+// GetChildChains returns secondary child chains registered by governance
+func (k Keeper) GetChildChains(ctx sdk.Context) (childChains []types.ChildChainParams) {
+	k.paramSpace.GetIfExists(ctx, types.KeyChildChains, &childChains)
+	return
+}
+
+// IsSecondaryChain checks if bor chain id belongs to registered secondary child chain
+func (k Keeper) IsSecondaryChain(ctx sdk.Context, borChainID string) bool {
+	for _, childChain := range k.GetChildChains(ctx) {
+		if childChain.BorChainID == borChainID {
+			return true
+		}
+	}
+
+	return false
+}
@@ -3,9 +3,12 @@ package chainmanager_test
 import (
 	"testing"
 
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/maticnetwork/heimdall/app"
 	"github.com/maticnetwork/heimdall/chainmanager/types"
+	paramsTypes "github.com/maticnetwork/heimdall/params/types"
+	hmTypes "github.com/maticnetwork/heimdall/types"
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 )
@@ -37,3 +40,62 @@ func (suite *KeeperTestSuite) TestParamsGetterSetter() {
 
 	require.Equal(t, params, actualParams)
 }
+
+func (suite *KeeperTestSuite) TestChildChains() {
+	t, app, ctx := suite.T(), suite.app, suite.ctx
+	params := types.DefaultParams()
+	params.ChildChains = []types.ChildChainParams{
+		{
+			BorChainID:           "80002",
+			RootChainAddress:     hmTypes.HexToHeimdallAddress("0x0000000000000000000000000000000000002000"),
+			StateSenderAddress:   hmTypes.HexToHeimdallAddress("0x0000000000000000000000000000000000002001"),
+			StateReceiverAddress: types.DefaultStateReceiverAddress,
+			ValidatorSetAddress:  types.DefaultValidatorSetAddress,
+		},
+	}
+
+	app.ChainKeeper.SetParams(ctx, params)
+
+	actualParams := app.ChainKeeper.GetParams(ctx)
+	require.Equal(t, params, actualParams)
+	require.Equal(t, []string{params.ChainParams.BorChainID, "80002"}, actualParams.ChildChainIDs())
+
+	childChain, ok := actualParams.GetChildChain("80002")
+	require.True(t, ok)
+	require.Equal(t, params.ChildChains[0], childChain)
+	require.True(t, actualParams.IsSecondaryChain("80002"))
+
+	// primary chain is registered but not secondary
+	_, ok = actualParams.GetChildChain(params.ChainParams.BorChainID)
+	require.True(t, ok)
+	require.False(t, actualParams.IsSecondaryChain(params.ChainParams.BorChainID))
+
+	_, ok = actualParams.GetChildChain("1234")
+	require.False(t, ok)
+
+	// duplicate chain id is invalid
+	params.ChildChains = append(params.ChildChains, params.ChildChains[0])
+	require.Error(t, params.Validate())
+}
+
+func (suite *KeeperTestSuite) TestMigrateParams() {
+	t, app, ctx := suite.T(), suite.app, suite.ctx
+	params := types.DefaultParams()
+	params.MainchainTxConfirmations = 12
+	app.ChainKeeper.SetParams(ctx, params)
+
+	// drop child chains param, as on chains started before it existed
+	store := prefix.NewStore(ctx.KVStore(app.GetKey(paramsTypes.StoreKey)), []byte(types.ModuleName+"/"))
+	store.Delete(types.KeyChildChains)
+	require.False(t, store.Has(types.KeyChildChains))
+
+	// missing param reads as default before migration
+	require.Equal(t, params, app.ChainKeeper.GetParams(ctx))
+
+	app.ChainKeeper.MigrateParams(ctx)
+	require.True(t, store.Has(types.KeyChildChains))
+
+	// missing param is set to default, existing params are kept
+	actualParams := app.ChainKeeper.GetParams(ctx)
+	require.Equal(t, params, actualParams)
+}
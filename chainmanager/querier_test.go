@@ -85,8 +85,11 @@ func (suite *QuerierTestSuite) TestQueryParams() {
 		rapp := app.Setup(true)
 		ctx := rapp.BaseApp.NewContext(true, abci.Header{})
 		querier := chainmanager.NewQuerier(rapp.ChainKeeper)
-		require.Panics(t, func() {
-			querier(ctx, path, req)
-		})
+
+		// params missing in store read as defaults
+		res, err := querier(ctx, path, req)
+		require.NoError(t, err)
+		require.NoError(t, json.Unmarshal(res, &params))
+		require.Equal(t, defaultParams.ChainParams, params.ChainParams)
 	}
 }
@@ -26,6 +26,7 @@ var (
 	KeyMainchainTxConfirmations  = []byte("MainchainTxConfirmations")
 	KeyMaticchainTxConfirmations = []byte("MaticchainTxConfirmations")
 	KeyChainParams               = []byte("ChainParams")
+	KeyChildChains               = []byte("ChildChains")
 )
 
 var _ subspace.ParamSet = &Params{}
@@ -59,11 +60,33 @@ func (cp ChainParams) String() string {
 		cp.BorChainID, cp.MaticTokenAddress, cp.StakingManagerAddress, cp.SlashManagerAddress, cp.RootChainAddress, cp.StakingInfoAddress, cp.StateSenderAddress, cp.StateReceiverAddress, cp.ValidatorSetAddress)
 }
 
+// ChildChainParams params of additional child chain checkpointed by heimdall
+type ChildChainParams struct {
+	BorChainID         string                  `json:"bor_chain_id" yaml:"bor_chain_id"`
+	RootChainAddress   hmTypes.HeimdallAddress `json:"root_chain_address" yaml:"root_chain_address"`
+	StateSenderAddress hmTypes.HeimdallAddress `json:"state_sender_address" yaml:"state_sender_address"`
+
+	// Bor Chain Contracts
+	StateReceiverAddress hmTypes.HeimdallAddress `json:"state_receiver_address" yaml:"state_receiver_address"`
+	ValidatorSetAddress  hmTypes.HeimdallAddress `json:"validator_set_address" yaml:"validator_set_address"`
+}
+
+func (cp ChildChainParams) String() string {
+	return fmt.Sprintf(`
+	BorChainID: 									%s
+	RootChainAddress:             %s
+	StateSenderAddress:           %s
+	StateReceiverAddress: 				%s
+	ValidatorSetAddress:					%s`,
+		cp.BorChainID, cp.RootChainAddress, cp.StateSenderAddress, cp.StateReceiverAddress, cp.ValidatorSetAddress)
+}
+
 // Params defines the parameters for the chainmanager module.
 type Params struct {
-	MainchainTxConfirmations  uint64      `json:"mainchain_tx_confirmations" yaml:"mainchain_tx_confirmations"`
-	MaticchainTxConfirmations uint64      `json:"maticchain_tx_confirmations" yaml:"maticchain_tx_confirmations"`
-	ChainParams               ChainParams `json:"chain_params" yaml:"chain_params"`
+	MainchainTxConfirmations  uint64             `json:"mainchain_tx_confirmations" yaml:"mainchain_tx_confirmations"`
+	MaticchainTxConfirmations uint64             `json:"maticchain_tx_confirmations" yaml:"maticchain_tx_confirmations"`
+	ChainParams               ChainParams        `json:"chain_params" yaml:"chain_params"`
+	ChildChains               []ChildChainParams `json:"child_chains" yaml:"child_chains"` // additional child chains
 }
 
 // NewParams creates a new Params object
@@ -83,6 +106,7 @@ func (p *Params) ParamSetPairs() subspace.ParamSetPairs {
 		{KeyMainchainTxConfirmations, &p.MainchainTxConfirmations},
 		{KeyMaticchainTxConfirmations, &p.MaticchainTxConfirmations},
 		{KeyChainParams, &p.ChainParams},
+		{KeyChildChains, &p.ChildChains},
 	}
 }
 
@@ -100,6 +124,9 @@ func (p Params) String() string {
 	sb.WriteString(fmt.Sprintf("MainchainTxConfirmations: %d\n", p.MainchainTxConfirmations))
 	sb.WriteString(fmt.Sprintf("MaticchainTxConfirmations: %d\n", p.MaticchainTxConfirmations))
 	sb.WriteString(fmt.Sprintf("ChainParams: %s\n", p.ChainParams.String()))
+	for _, childChain := range p.ChildChains {
+		sb.WriteString(fmt.Sprintf("ChildChain: %s\n", childChain.String()))
+	}
 	return sb.String()
 }
 
@@ -137,9 +164,79 @@ func (p Params) Validate() error {
 		return err
 	}
 
+	chainIDs := map[string]bool{p.ChainParams.BorChainID: true}
+	for _, childChain := range p.ChildChains {
+		if childChain.BorChainID == "" {
+			return fmt.Errorf("Invalid bor_chain_id in child_chains")
+		}
+
+		if chainIDs[childChain.BorChainID] {
+			return fmt.Errorf("Duplicate bor_chain_id %s in child_chains", childChain.BorChainID)
+		}
+
+		chainIDs[childChain.BorChainID] = true
+
+		if err := validateHeimdallAddress("root_chain_address", childChain.RootChainAddress); err != nil {
+			return err
+		}
+
+		if err := validateHeimdallAddress("state_sender_address", childChain.StateSenderAddress); err != nil {
+			return err
+		}
+
+		if err := validateHeimdallAddress("state_receiver_address", childChain.StateReceiverAddress); err != nil {
+			return err
+		}
+
+		if err := validateHeimdallAddress("validator_set_address", childChain.ValidatorSetAddress); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
+// GetChildChain returns params of registered child chain, primary chain included
+func (p Params) GetChildChain(borChainID string) (ChildChainParams, bool) {
+	if borChainID == p.ChainParams.BorChainID {
+		return ChildChainParams{
+			BorChainID:           p.ChainParams.BorChainID,
+			RootChainAddress:     p.ChainParams.RootChainAddress,
+			StateSenderAddress:   p.ChainParams.StateSenderAddress,
+			StateReceiverAddress: p.ChainParams.StateReceiverAddress,
+			ValidatorSetAddress:  p.ChainParams.ValidatorSetAddress,
+		}, true
+	}
+
+	for _, childChain := range p.ChildChains {
+		if childChain.BorChainID == borChainID {
+			return childChain, true
+		}
+	}
+
+	return ChildChainParams{}, false
+}
+
+// IsSecondaryChain checks if chain id belongs to registered child chain other than primary chain
+func (p Params) IsSecondaryChain(borChainID string) bool {
+	if borChainID == p.ChainParams.BorChainID {
+		return false
+	}
+
+	_, ok := p.GetChildChain(borChainID)
+	return ok
+}
+
+// ChildChainIDs returns chain ids of all registered child chains, primary chain first
+func (p Params) ChildChainIDs() []string {
+	chainIDs := []string{p.ChainParams.BorChainID}
+	for _, childChain := range p.ChildChains {
+		chainIDs = append(chainIDs, childChain.BorChainID)
+	}
+
+	return chainIDs
+}
+
 func validateHeimdallAddress(key string, value hmTypes.HeimdallAddress) error {
 	if value.String() == "" {
 		return fmt.Errorf("Invalid value %s in chain_params", key)
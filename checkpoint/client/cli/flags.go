@@ -11,4 +11,7 @@ const (
 	FlagCheckpointTxHash   = "txhash"
 	FlagCheckpointLogIndex = "log-index"
 	FlagAutoConfigure      = "auto-configure"
+	FlagBorBlock           = "block"
+	FlagBlockHeaderHash    = "header-hash"
+	FlagBlockProof         = "proof"
 )
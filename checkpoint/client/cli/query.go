@@ -10,11 +10,13 @@ import (
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/context"
 	"github.com/cosmos/cosmos-sdk/codec"
+	ethCommon "github.com/maticnetwork/bor/common"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 
 	"github.com/maticnetwork/heimdall/checkpoint/types"
 	hmClient "github.com/maticnetwork/heimdall/client"
+	hmTypes "github.com/maticnetwork/heimdall/types"
 	"github.com/maticnetwork/heimdall/version"
 )
 
@@ -36,6 +38,11 @@ func GetQueryCmd(cdc *codec.Codec) *cobra.Command {
 			GetCheckpointBuffer(cdc),
 			GetLastNoACK(cdc),
 			GetHeaderFromIndex(cdc),
+			GetCheckpointSignatures(cdc),
+			GetCheckpointByBorBlock(cdc),
+			GetCheckpointByRootHash(cdc),
+			GetBlockProof(cdc),
+			VerifyBlockProof(cdc),
 			GetCheckpointCount(cdc),
 		)...,
 	)
@@ -84,7 +91,13 @@ func GetCheckpointBuffer(cdc *codec.Codec) *cobra.Command {
 		RunE: func(cmd *cobra.Command, args []string) error {
 			cliCtx := context.NewCLIContext().WithCodec(cdc)
 
-			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryCheckpointBuffer), nil)
+			// get query params
+			queryParams, err := cliCtx.Codec.MarshalJSON(types.NewQueryBorChainID(viper.GetString(FlagBorChainID)))
+			if err != nil {
+				return err
+			}
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryCheckpointBuffer), queryParams)
 			if err != nil {
 				return err
 			}
@@ -98,6 +111,8 @@ func GetCheckpointBuffer(cdc *codec.Codec) *cobra.Command {
 		},
 	}
 
+	cmd.Flags().String(FlagBorChainID, "", "--bor-chain-id=<bor-chain-id>")
+
 	return cmd
 }
 
@@ -141,7 +156,7 @@ func GetHeaderFromIndex(cdc *codec.Codec) *cobra.Command {
 			headerNumber := viper.GetUint64(FlagHeaderNumber)
 
 			// get query params
-			queryParams, err := cliCtx.Codec.MarshalJSON(types.NewQueryCheckpointParams(headerNumber))
+			queryParams, err := cliCtx.Codec.MarshalJSON(types.NewQueryCheckpointParams(headerNumber, viper.GetString(FlagBorChainID)))
 			if err != nil {
 				return err
 			}
@@ -158,6 +173,7 @@ func GetHeaderFromIndex(cdc *codec.Codec) *cobra.Command {
 	}
 
 	cmd.Flags().Uint64(FlagHeaderNumber, 0, "--header=<header-number>")
+	cmd.Flags().String(FlagBorChainID, "", "--bor-chain-id=<bor-chain-id>")
 	if err := cmd.MarkFlagRequired(FlagHeaderNumber); err != nil {
 		logger.Error("GetHeaderFromIndex | MarkFlagRequired | FlagHeaderNumber", "Error", err)
 	}
@@ -165,6 +181,204 @@ func GetHeaderFromIndex(cdc *codec.Codec) *cobra.Command {
 	return cmd
 }
 
+// GetCheckpointSignatures get side-tx data and sigs which approved checkpoint
+func GetCheckpointSignatures(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "signatures",
+		Short: "get side-tx data and sigs of checkpoint (checkpoint in buffer without --header)",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			headerNumber := viper.GetUint64(FlagHeaderNumber)
+			borChainID := viper.GetString(FlagBorChainID)
+
+			// get query params
+			route := types.QuerySignatures
+			queryParams, err := cliCtx.Codec.MarshalJSON(types.NewQueryCheckpointParams(headerNumber, borChainID))
+			if headerNumber == 0 {
+				route = types.QueryBufferSignatures
+				queryParams, err = cliCtx.Codec.MarshalJSON(types.NewQueryBorChainID(borChainID))
+			}
+			if err != nil {
+				return err
+			}
+
+			// fetch signatures
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, route), queryParams)
+			if err != nil {
+				return err
+			}
+
+			fmt.Printf(string(res))
+			return nil
+		},
+	}
+
+	cmd.Flags().Uint64(FlagHeaderNumber, 0, "--header=<header-number>")
+	cmd.Flags().String(FlagBorChainID, "", "--bor-chain-id=<bor-chain-id>")
+
+	return cmd
+}
+
+// GetCheckpointByBorBlock get checkpoint which includes given bor block
+func GetCheckpointByBorBlock(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "checkpoint-by-block",
+		Short: "get checkpoint (header) which includes bor block",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			borBlock := viper.GetUint64(FlagBorBlock)
+
+			// get query params
+			queryParams, err := cliCtx.Codec.MarshalJSON(types.NewQueryBorBlockParams(borBlock, viper.GetString(FlagBorChainID)))
+			if err != nil {
+				return err
+			}
+
+			// fetch checkpoint
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryCheckpointByBlock), queryParams)
+			if err != nil {
+				return err
+			}
+
+			fmt.Printf(string(res))
+			return nil
+		},
+	}
+
+	cmd.Flags().Uint64(FlagBorBlock, 0, "--block=<bor-block-number>")
+	cmd.Flags().String(FlagBorChainID, "", "--bor-chain-id=<bor-chain-id>")
+	if err := cmd.MarkFlagRequired(FlagBorBlock); err != nil {
+		logger.Error("GetCheckpointByBorBlock | MarkFlagRequired | FlagBorBlock", "Error", err)
+	}
+
+	return cmd
+}
+
+// GetCheckpointByRootHash get checkpoint given its root hash
+func GetCheckpointByRootHash(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "checkpoint-by-root",
+		Short: "get checkpoint (header) from root hash",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			rootHash := hmTypes.HexToHeimdallHash(viper.GetString(FlagRootHash))
+
+			// get query params
+			queryParams, err := cliCtx.Codec.MarshalJSON(types.NewQueryRootHashParams(rootHash, viper.GetString(FlagBorChainID)))
+			if err != nil {
+				return err
+			}
+
+			// fetch checkpoint
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryCheckpointByRoot), queryParams)
+			if err != nil {
+				return err
+			}
+
+			fmt.Printf(string(res))
+			return nil
+		},
+	}
+
+	cmd.Flags().String(FlagRootHash, "", "--root-hash=<root-hash>")
+	cmd.Flags().String(FlagBorChainID, "", "--bor-chain-id=<bor-chain-id>")
+	if err := cmd.MarkFlagRequired(FlagRootHash); err != nil {
+		logger.Error("GetCheckpointByRootHash | MarkFlagRequired | FlagRootHash", "Error", err)
+	}
+
+	return cmd
+}
+
+// GetBlockProof get proof of bor block inclusion in checkpoint
+func GetBlockProof(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "proof",
+		Short: "get proof of bor block header inclusion in checkpoint root hash",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			borBlock := viper.GetUint64(FlagBorBlock)
+
+			// get query params
+			queryParams, err := cliCtx.Codec.MarshalJSON(types.NewQueryBorBlockParams(borBlock, viper.GetString(FlagBorChainID)))
+			if err != nil {
+				return err
+			}
+
+			// fetch proof
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryCheckpointProof), queryParams)
+			if err != nil {
+				return err
+			}
+
+			fmt.Printf(string(res))
+			return nil
+		},
+	}
+
+	cmd.Flags().Uint64(FlagBorBlock, 0, "--block=<bor-block-number>")
+	cmd.Flags().String(FlagBorChainID, "", "--bor-chain-id=<bor-chain-id>")
+	if err := cmd.MarkFlagRequired(FlagBorBlock); err != nil {
+		logger.Error("GetBlockProof | MarkFlagRequired | FlagBorBlock", "Error", err)
+	}
+
+	return cmd
+}
+
+// VerifyBlockProof verifies proof of bor block inclusion against checkpoint stored on heimdall
+func VerifyBlockProof(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "verify-proof",
+		Short: "verify proof of bor block header inclusion against checkpoint on heimdall",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			borBlock := viper.GetUint64(FlagBorBlock)
+			headerHash := hmTypes.HexToHeimdallHash(viper.GetString(FlagBlockHeaderHash))
+			proof := ethCommon.FromHex(viper.GetString(FlagBlockProof))
+
+			// get query params
+			queryParams, err := cliCtx.Codec.MarshalJSON(types.NewQueryBorBlockParams(borBlock, viper.GetString(FlagBorChainID)))
+			if err != nil {
+				return err
+			}
+
+			// fetch checkpoint covering block
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryCheckpointByBlock), queryParams)
+			if err != nil {
+				return err
+			}
+
+			var result types.CheckpointWithNumber
+			if err := json.Unmarshal(res, &result); err != nil {
+				return err
+			}
+
+			index := borBlock - result.Checkpoint.StartBlock
+			if !types.VerifyBlockProof(headerHash.Bytes(), index, proof, result.Checkpoint.RootHash.Bytes()) {
+				return fmt.Errorf("Invalid proof for block %v against checkpoint %v", borBlock, result.Number)
+			}
+
+			fmt.Printf("Valid proof for block %v in checkpoint %v (root hash %v)", borBlock, result.Number, result.Checkpoint.RootHash)
+			return nil
+		},
+	}
+
+	cmd.Flags().Uint64(FlagBorBlock, 0, "--block=<bor-block-number>")
+	cmd.Flags().String(FlagBlockHeaderHash, "", "--header-hash=<block-header-hash>")
+	cmd.Flags().String(FlagBlockProof, "", "--proof=<proof>")
+	cmd.Flags().String(FlagBorChainID, "", "--bor-chain-id=<bor-chain-id>")
+	if err := cmd.MarkFlagRequired(FlagBorBlock); err != nil {
+		logger.Error("VerifyBlockProof | MarkFlagRequired | FlagBorBlock", "Error", err)
+	}
+	if err := cmd.MarkFlagRequired(FlagBlockHeaderHash); err != nil {
+		logger.Error("VerifyBlockProof | MarkFlagRequired | FlagBlockHeaderHash", "Error", err)
+	}
+	if err := cmd.MarkFlagRequired(FlagBlockProof); err != nil {
+		logger.Error("VerifyBlockProof | MarkFlagRequired | FlagBlockProof", "Error", err)
+	}
+
+	return cmd
+}
+
 // GetCheckpointCount get number of checkpoint received count
 func GetCheckpointCount(cdc *codec.Codec) *cobra.Command {
 	cmd := &cobra.Command{
@@ -173,7 +387,13 @@ func GetCheckpointCount(cdc *codec.Codec) *cobra.Command {
 		RunE: func(cmd *cobra.Command, args []string) error {
 			cliCtx := context.NewCLIContext().WithCodec(cdc)
 
-			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryAckCount), nil)
+			// get query params
+			queryParams, err := cliCtx.Codec.MarshalJSON(types.NewQueryBorChainID(viper.GetString(FlagBorChainID)))
+			if err != nil {
+				return err
+			}
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryAckCount), queryParams)
 			if err != nil {
 				return err
 			}
@@ -192,5 +412,7 @@ func GetCheckpointCount(cdc *codec.Codec) *cobra.Command {
 		},
 	}
 
+	cmd.Flags().String(FlagBorChainID, "", "--bor-chain-id=<bor-chain-id>")
+
 	return cmd
 }
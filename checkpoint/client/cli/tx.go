@@ -217,9 +217,20 @@ func SendCheckpointACKTx(cdc *codec.Codec) *cobra.Command {
 				return errors.New("Transaction is not confirmed yet. Please wait for sometime and try again")
 			}
 
+			// ack is for primary chain unless chain id is provided
+			borChainID := viper.GetString(FlagBorChainID)
+			if borChainID == "" {
+				borChainID = chainmanagerParams.ChainParams.BorChainID
+			}
+
+			childChain, ok := chainmanagerParams.GetChildChain(borChainID)
+			if !ok {
+				return fmt.Errorf("bor chain id %v is not registered", borChainID)
+			}
+
 			// decode new header block event
 			res, err := contractCallerObj.DecodeNewHeaderBlockEvent(
-				chainmanagerParams.ChainParams.RootChainAddress.EthAddress(),
+				childChain.RootChainAddress.EthAddress(),
 				receipt,
 				uint64(viper.GetInt64(FlagCheckpointLogIndex)),
 			)
@@ -237,6 +248,7 @@ func SendCheckpointACKTx(cdc *codec.Codec) *cobra.Command {
 				res.Root,
 				txHash,
 				uint64(viper.GetInt64(FlagCheckpointLogIndex)),
+				borChainID,
 			)
 
 			// msg
@@ -248,6 +260,7 @@ func SendCheckpointACKTx(cdc *codec.Codec) *cobra.Command {
 	cmd.Flags().String(FlagHeaderNumber, "", "--header=<header-index>")
 	cmd.Flags().StringP(FlagCheckpointTxHash, "t", "", "--txhash=<checkpoint-txhash>")
 	cmd.Flags().String(FlagCheckpointLogIndex, "", "--log-index=<log-index>")
+	cmd.Flags().String(FlagBorChainID, "", "--bor-chain-id=<bor-chain-id>")
 
 	if err := cmd.MarkFlagRequired(FlagHeaderNumber); err != nil {
 		logger.Error("SendCheckpointACKTx | MarkFlagRequired | FlagHeaderNumber", "Error", err)
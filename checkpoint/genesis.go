@@ -44,6 +44,30 @@ func InitGenesis(ctx sdk.Context, keeper Keeper, data types.GenesisState) {
 
 	// Set initial ack count
 	keeper.UpdateACKCountWithValue(ctx, data.AckCount)
+
+	// Add side-tx data and sigs of checkpoints
+	initCheckpointSignatures(ctx, keeper, "", data.BufferedCheckpointSignatures, data.CheckpointSignatures)
+
+	// Add state of secondary child chains, registered by chainmanager genesis
+	for _, childChain := range data.ChildChains {
+		for i, checkpoint := range hmTypes.SortHeaders(childChain.Checkpoints) {
+			if err := keeper.AddCheckpoint(ctx, uint64(i)+1, checkpoint); err != nil {
+				keeper.Logger(ctx).Error("InitGenesis | AddCheckpoint", "borChainID", childChain.BorChainID, "error", err)
+			}
+		}
+
+		if childChain.BufferedCheckpoint != nil {
+			if err := keeper.SetCheckpointBuffer(ctx, *childChain.BufferedCheckpoint); err != nil {
+				keeper.Logger(ctx).Error("InitGenesis | SetCheckpointBuffer", "borChainID", childChain.BorChainID, "error", err)
+			}
+		}
+
+		keeper.UpdateACKCountWithValueForChain(ctx, childChain.BorChainID, childChain.AckCount)
+		initCheckpointSignatures(ctx, keeper, childChain.BorChainID, childChain.BufferedCheckpointSignatures, childChain.CheckpointSignatures)
+	}
+
+	// checkpoints are indexed by AddCheckpoint, mark indexes as current
+	keeper.setCheckpointIndexVersion(ctx)
 }
 
 // ExportGenesis returns a GenesisState for a given context and keeper.
@@ -51,11 +75,48 @@ func ExportGenesis(ctx sdk.Context, keeper Keeper) types.GenesisState {
 	params := keeper.GetParams(ctx)
 
 	bufferedCheckpoint, _ := keeper.GetCheckpointFromBuffer(ctx)
-	return types.NewGenesisState(
+	genesisState := types.NewGenesisState(
 		params,
 		bufferedCheckpoint,
 		keeper.GetLastNoAck(ctx),
 		keeper.GetACKCount(ctx),
 		hmTypes.SortHeaders(keeper.GetCheckpoints(ctx)),
 	)
+	genesisState.BufferedCheckpointSignatures, genesisState.CheckpointSignatures = exportCheckpointSignatures(ctx, keeper, "")
+
+	// export state of secondary child chains
+	for _, childChain := range keeper.ck.GetChildChains(ctx) {
+		childBuffered, _ := keeper.GetCheckpointFromBufferForChain(ctx, childChain.BorChainID)
+		childState := types.ChildChainGenesisState{
+			BorChainID:         childChain.BorChainID,
+			BufferedCheckpoint: childBuffered,
+			AckCount:           keeper.GetACKCountForChain(ctx, childChain.BorChainID),
+			Checkpoints:        hmTypes.SortHeaders(keeper.GetCheckpointsForChain(ctx, childChain.BorChainID)),
+		}
+		childState.BufferedCheckpointSignatures, childState.CheckpointSignatures = exportCheckpointSignatures(ctx, keeper, childChain.BorChainID)
+		genesisState.ChildChains = append(genesisState.ChildChains, childState)
+	}
+
+	return genesisState
+}
+
+// initCheckpointSignatures sets side-tx data and sigs of buffered and acked checkpoints of child chain
+func initCheckpointSignatures(ctx sdk.Context, keeper Keeper, borChainID string, buffered *types.CheckpointSignatures, acked []types.AckedCheckpointSignatures) {
+	if buffered != nil {
+		keeper.SetCheckpointBufferSignatures(ctx, borChainID, *buffered)
+	}
+
+	for _, signatures := range acked {
+		keeper.SetCheckpointSignatures(ctx, borChainID, signatures.Number, signatures.Signatures)
+	}
+}
+
+// exportCheckpointSignatures returns side-tx data and sigs of buffered and acked checkpoints of child chain
+func exportCheckpointSignatures(ctx sdk.Context, keeper Keeper, borChainID string) (*types.CheckpointSignatures, []types.AckedCheckpointSignatures) {
+	var buffered *types.CheckpointSignatures
+	if signatures, err := keeper.GetCheckpointBufferSignaturesForChain(ctx, borChainID); err == nil {
+		buffered = &signatures
+	}
+
+	return buffered, keeper.GetAllCheckpointSignaturesForChain(ctx, borChainID)
 }
@@ -7,6 +7,7 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/maticnetwork/heimdall/app"
+	chainmanagerTypes "github.com/maticnetwork/heimdall/chainmanager/types"
 	"github.com/maticnetwork/heimdall/checkpoint"
 	"github.com/maticnetwork/heimdall/checkpoint/types"
 	hmTypes "github.com/maticnetwork/heimdall/types"
@@ -82,3 +83,85 @@ func (suite *GenesisTestSuite) TestInitExportGenesis() {
 	require.LessOrEqual(t, len(actualParams.Checkpoints), len(genesisState.Checkpoints))
 
 }
+
+func (suite *GenesisTestSuite) TestInitExportChildChainGenesis() {
+	t, app, ctx := suite.T(), suite.app, suite.ctx
+
+	// register secondary child chain (chainmanager genesis runs first)
+	chainParams := app.ChainKeeper.GetParams(ctx)
+	chainParams.ChildChains = []chainmanagerTypes.ChildChainParams{
+		{
+			BorChainID:           "80002",
+			RootChainAddress:     hmTypes.HexToHeimdallAddress("0x0000000000000000000000000000000000002000"),
+			StateReceiverAddress: chainmanagerTypes.DefaultStateReceiverAddress,
+			ValidatorSetAddress:  chainmanagerTypes.DefaultValidatorSetAddress,
+		},
+	}
+	app.ChainKeeper.SetParams(ctx, chainParams)
+
+	proposer := hmTypes.HexToHeimdallAddress("123")
+	timestamp := uint64(time.Now().Unix())
+
+	primaryCheckpoint := hmTypes.CreateBlock(0, 255, hmTypes.HexToHeimdallHash("111"), proposer, chainParams.ChainParams.BorChainID, timestamp)
+	childCheckpoints := []hmTypes.Checkpoint{
+		hmTypes.CreateBlock(0, 127, hmTypes.HexToHeimdallHash("222"), proposer, "80002", timestamp),
+		hmTypes.CreateBlock(128, 255, hmTypes.HexToHeimdallHash("333"), proposer, "80002", timestamp+1),
+	}
+	childBuffered := hmTypes.CreateBlock(256, 383, hmTypes.HexToHeimdallHash("444"), proposer, "80002", timestamp+2)
+
+	genesisState := types.NewGenesisState(
+		types.DefaultParams(),
+		nil,
+		0,
+		1,
+		[]hmTypes.Checkpoint{primaryCheckpoint},
+	)
+	genesisState.CheckpointSignatures = []types.AckedCheckpointSignatures{
+		{Number: 1, Signatures: types.CheckpointSignatures{TxHash: hmTypes.HexToHeimdallHash("0x01"), Data: []byte{1}, Sigs: []byte{1, 1}}},
+	}
+	childBufferedSignatures := types.CheckpointSignatures{TxHash: hmTypes.HexToHeimdallHash("0x04"), Data: []byte{4}, Sigs: []byte{4, 4}}
+	genesisState.ChildChains = []types.ChildChainGenesisState{
+		{
+			BorChainID:                   "80002",
+			BufferedCheckpoint:           &childBuffered,
+			AckCount:                     2,
+			Checkpoints:                  childCheckpoints,
+			BufferedCheckpointSignatures: &childBufferedSignatures,
+			CheckpointSignatures: []types.AckedCheckpointSignatures{
+				{Number: 2, Signatures: types.CheckpointSignatures{TxHash: hmTypes.HexToHeimdallHash("0x03"), Data: []byte{3}, Sigs: []byte{3, 3}}},
+			},
+		},
+	}
+	require.NoError(t, types.ValidateGenesis(genesisState))
+
+	checkpoint.InitGenesis(ctx, app.CheckpointKeeper, genesisState)
+
+	// child chain state is kept apart from primary chain state
+	require.Equal(t, uint64(1), app.CheckpointKeeper.GetACKCount(ctx))
+	require.Equal(t, uint64(2), app.CheckpointKeeper.GetACKCountForChain(ctx, "80002"))
+	lastCheckpoint, err := app.CheckpointKeeper.GetLastCheckpointForChain(ctx, "80002")
+	require.NoError(t, err)
+	require.Equal(t, childCheckpoints[1], lastCheckpoint)
+
+	// side-tx data and sigs are kept per chain
+	signatures, err := app.CheckpointKeeper.GetCheckpointSignaturesForChain(ctx, "80002", 2)
+	require.NoError(t, err)
+	require.Equal(t, genesisState.ChildChains[0].CheckpointSignatures[0].Signatures, signatures)
+	_, err = app.CheckpointKeeper.GetCheckpointSignaturesForChain(ctx, "80002", 1)
+	require.Error(t, err)
+
+	exported := checkpoint.ExportGenesis(ctx, app.CheckpointKeeper)
+	require.Equal(t, genesisState.Checkpoints, exported.Checkpoints)
+	require.Equal(t, genesisState.CheckpointSignatures, exported.CheckpointSignatures)
+	require.Nil(t, exported.BufferedCheckpointSignatures)
+	require.Equal(t, genesisState.ChildChains, exported.ChildChains)
+
+	// sigs of checkpoint which is not acked are rejected
+	genesisState.CheckpointSignatures[0].Number = 2
+	require.Error(t, types.ValidateGenesis(genesisState))
+	genesisState.CheckpointSignatures[0].Number = 1
+
+	// checkpoint of other chain is rejected in child chain state
+	genesisState.ChildChains[0].Checkpoints[0] = primaryCheckpoint
+	require.Error(t, types.ValidateGenesis(genesisState))
+}
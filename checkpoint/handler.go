@@ -41,13 +41,14 @@ func handleMsgCheckpoint(ctx sdk.Context, msg types.MsgCheckpoint, k Keeper, con
 	// Check checkpoint buffer
 	//
 
-	checkpointBuffer, err := k.GetCheckpointFromBuffer(ctx)
+	checkpointBuffer, err := k.GetCheckpointFromBufferForChain(ctx, msg.BorChainID)
 	if err == nil {
 		checkpointBufferTime := uint64(params.CheckpointBufferTime.Seconds())
 
 		if checkpointBuffer.TimeStamp == 0 || ((timeStamp > checkpointBuffer.TimeStamp) && timeStamp-checkpointBuffer.TimeStamp >= checkpointBufferTime) {
 			logger.Debug("Checkpoint has been timed out. Flushing buffer.", "checkpointTimestamp", timeStamp, "prevCheckpointTimestamp", checkpointBuffer.TimeStamp)
-			k.FlushCheckpointBuffer(ctx)
+			k.RecordCheckpointFlushed(ctx, *checkpointBuffer)
+			k.FlushCheckpointBufferForChain(ctx, msg.BorChainID)
 		} else {
 			expiryTime := checkpointBuffer.TimeStamp + checkpointBufferTime
 			logger.Error("Checkpoint already exits in buffer", "Checkpoint", checkpointBuffer.String(), "Expires", expiryTime)
@@ -60,7 +61,7 @@ func handleMsgCheckpoint(ctx sdk.Context, msg types.MsgCheckpoint, k Keeper, con
 	//
 
 	// fetch last checkpoint from store
-	if lastCheckpoint, err := k.GetLastCheckpoint(ctx); err == nil {
+	if lastCheckpoint, err := k.GetLastCheckpointForChain(ctx, msg.BorChainID); err == nil {
 		// make sure new checkpoint is after tip
 		if lastCheckpoint.EndBlock > msg.StartBlock {
 			logger.Error("Checkpoint already exists",
@@ -151,8 +152,8 @@ func handleMsgCheckpoint(ctx sdk.Context, msg types.MsgCheckpoint, k Keeper, con
 func handleMsgCheckpointAck(ctx sdk.Context, msg types.MsgCheckpointAck, k Keeper, contractCaller helper.IContractCaller) sdk.Result {
 	logger := k.Logger(ctx)
 
-	// Get last checkpoint from buffer
-	headerBlock, err := k.GetCheckpointFromBuffer(ctx)
+	// Get last checkpoint from buffer of child chain
+	headerBlock, err := k.GetCheckpointFromBufferForChain(ctx, msg.BorChainID)
 	if err != nil {
 		logger.Error("Unable to get checkpoint", "error", err)
 		return common.ErrBadAck(k.Codespace()).Result()
@@ -228,6 +229,10 @@ func handleMsgCheckpointNoAck(ctx sdk.Context, msg types.MsgCheckpointNoAck, k K
 	// Update to new proposer
 	//
 
+	// Record no-ack against proposer being replaced, no-ack follows checkpoints of primary chain
+	prevValidatorSet := k.sk.GetValidatorSet(ctx)
+	k.RecordNoAck(ctx, "", msg.From, prevValidatorSet.GetProposer().Signer)
+
 	// Increment accum (selects new proposer)
 	k.sk.IncrementAccum(ctx, 1)
 
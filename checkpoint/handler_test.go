@@ -189,6 +189,22 @@ func (suite *HandlerTestSuite) TestHandleMsgCheckpointAfterBufferTimeOut() {
 	// send new checkpoint which should replace old one
 	got := suite.SendCheckpoint(header)
 	require.True(t, got.IsOK(), "expected send-checkpoint to be  ok, got %v", got)
+
+	// flushed checkpoint is recorded
+	stats := keeper.GetCheckpointStats(suite.ctx)
+	require.Equal(t, uint64(2), stats.Buffered)
+	require.Equal(t, uint64(1), stats.Flushed)
+
+	flushed := keeper.GetFlushedCheckpoints(suite.ctx)
+	require.Len(t, flushed, 1)
+	require.Equal(t, header.Proposer, flushed[0].Proposer)
+	require.Equal(t, checkpointBuffer.TimeStamp, flushed[0].BufferedAt)
+
+	proposer, err := stakingKeeper.GetValidatorInfo(suite.ctx, header.Proposer.Bytes())
+	require.NoError(t, err)
+	proposerStats := keeper.GetProposerStats(suite.ctx, proposer.ID)
+	require.Equal(t, uint64(2), proposerStats.Buffered)
+	require.Equal(t, uint64(1), proposerStats.Flushed)
 }
 
 func (suite *HandlerTestSuite) TestHandleMsgCheckpointExistInBuffer() {
@@ -274,6 +290,7 @@ func (suite *HandlerTestSuite) TestHandleMsgCheckpointAck() {
 			header.RootHash,
 			hmTypes.HexToHeimdallHash("123123"),
 			uint64(1),
+			header.BorChainID,
 		)
 		result := suite.handler(ctx, msgCheckpointAck)
 		require.True(t, result.IsOK(), "expected send-ack to be ok, got %v", result)
@@ -291,6 +308,7 @@ func (suite *HandlerTestSuite) TestHandleMsgCheckpointAck() {
 			header.RootHash,
 			hmTypes.HexToHeimdallHash("123123"),
 			uint64(1),
+			header.BorChainID,
 		)
 
 		got := suite.handler(ctx, msgCheckpointAck)
@@ -307,6 +325,7 @@ func (suite *HandlerTestSuite) TestHandleMsgCheckpointAck() {
 			hmTypes.HexToHeimdallHash("9887"),
 			hmTypes.HexToHeimdallHash("123123"),
 			uint64(1),
+			header.BorChainID,
 		)
 
 		got := suite.handler(ctx, msgCheckpointAck)
@@ -355,6 +374,17 @@ func (suite *HandlerTestSuite) TestHandleMsgCheckpointNoAck() {
 	require.True(t, result.IsOK(), "expected send-NoAck to be ok, got %v", got)
 	ackCount := keeper.GetACKCount(ctx)
 	require.Equal(t, uint64(0), uint64(ackCount), "Should not update state")
+
+	// no-ack is recorded against replaced proposer
+	require.Equal(t, uint64(1), keeper.GetCheckpointStats(ctx).NoAcks)
+	records := keeper.GetNoAckRecords(ctx)
+	require.Len(t, records, 1)
+	require.Equal(t, hmTypes.HexToHeimdallAddress("123"), records[0].From)
+	require.Equal(t, header.Proposer, records[0].Proposer)
+
+	proposer, err := stakingKeeper.GetValidatorInfo(ctx, header.Proposer.Bytes())
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), keeper.GetProposerStats(ctx, proposer.ID).NoAcks)
 }
 
 func (suite *HandlerTestSuite) TestHandleMsgCheckpointNoAckBeforeBufferTimeout() {
@@ -1,10 +1,12 @@
 package checkpoint
 
 import (
+	"encoding/binary"
 	"errors"
 	"strconv"
 
 	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/tendermint/tendermint/libs/log"
 
@@ -23,11 +25,33 @@ var (
 	BufferCheckpointKey = []byte{0x12} // Key to store checkpoint in buffer
 	CheckpointKey       = []byte{0x13} // prefix key for when storing checkpoint after ACK
 	LastNoACKKey        = []byte{0x14} // key to store last no-ack
+
+	CheckpointByEndBlockKey   = []byte{0x15} // prefix key to index checkpoint number by end block
+	CheckpointByRootKey       = []byte{0x16} // prefix key to index checkpoint number by root hash
+	CheckpointIndexVersionKey = []byte{0x17} // key to store version of checkpoint indexes
+
+	CheckpointStatsKey     = []byte{0x18} // key to store global checkpoint stats
+	ProposerStatsKey       = []byte{0x19} // prefix key to store checkpoint stats of proposer
+	CheckpointLifecycleKey = []byte{0x1A} // prefix key to store lifecycle of acked checkpoint
+	FlushedCheckpointKey   = []byte{0x1B} // prefix key to store checkpoints flushed on timeout
+	NoAckRecordKey         = []byte{0x1C} // prefix key to store accepted no-acks
+
+	ChildChainKey = []byte{0x1D} // prefix key to store checkpoint state of secondary child chains
+
+	BufferCheckpointSignaturesKey = []byte{0x1E} // key to store side-tx sigs of checkpoint in buffer
+	CheckpointSignaturesKey       = []byte{0x1F} // prefix key to store side-tx sigs of checkpoint after ACK
+
+	ProposerMissInfoKey     = []byte{0x20} // prefix key to store no-ack miss window of proposer
+	ProposerMissBitArrayKey = []byte{0x21} // prefix key to store missed checkpoints in window of proposer
 )
 
+// CheckpointIndexVersion is the version of checkpoint indexes, indexes are rebuilt when stored version is lower
+const CheckpointIndexVersion uint64 = 1
+
 // ModuleCommunicator manages different module interaction
 type ModuleCommunicator interface {
 	GetAllDividendAccounts(ctx sdk.Context) []hmTypes.DividendAccount
+	SlashInterim(ctx sdk.Context, valID hmTypes.ValidatorID, slashFraction sdk.Dec, infraction string) uint64
 }
 
 // Keeper stores all related data
@@ -79,18 +103,30 @@ func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", types.ModuleName)
 }
 
-// AddCheckpoint adds checkpoint into final blocks
+// chainStore returns checkpoint store of child chain.
+// Primary chain (and unregistered chain id) uses module store, secondary child chains use prefixed store.
+func (k *Keeper) chainStore(ctx sdk.Context, borChainID string) sdk.KVStore {
+	store := ctx.KVStore(k.storeKey)
+	if borChainID == "" || !k.ck.IsSecondaryChain(ctx, borChainID) {
+		return store
+	}
+
+	return prefix.NewStore(store, GetChildChainKey(borChainID))
+}
+
+// AddCheckpoint adds checkpoint into final blocks of its child chain
 func (k *Keeper) AddCheckpoint(ctx sdk.Context, checkpointNumber uint64, checkpoint hmTypes.Checkpoint) error {
 	key := GetCheckpointKey(checkpointNumber)
 	err := k.addCheckpoint(ctx, key, checkpoint)
 	if err != nil {
 		return err
 	}
+	k.setCheckpointIndexes(ctx, checkpointNumber, checkpoint)
 	k.Logger(ctx).Info("Adding good checkpoint to state", "checkpoint", checkpoint, "checkpointNumber", checkpointNumber)
 	return nil
 }
 
-// SetCheckpointBuffer flushes Checkpoint Buffer
+// SetCheckpointBuffer sets checkpoint in buffer of its child chain
 func (k *Keeper) SetCheckpointBuffer(ctx sdk.Context, checkpoint hmTypes.Checkpoint) error {
 	err := k.addCheckpoint(ctx, BufferCheckpointKey, checkpoint)
 	if err != nil {
@@ -99,9 +135,9 @@ func (k *Keeper) SetCheckpointBuffer(ctx sdk.Context, checkpoint hmTypes.Checkpo
 	return nil
 }
 
-// addCheckpoint adds checkpoint to store
+// addCheckpoint adds checkpoint to store of its child chain
 func (k *Keeper) addCheckpoint(ctx sdk.Context, key []byte, checkpoint hmTypes.Checkpoint) error {
-	store := ctx.KVStore(k.storeKey)
+	store := k.chainStore(ctx, checkpoint.BorChainID)
 
 	// create Checkpoint block and marshall
 	out, err := k.cdc.MarshalBinaryBare(checkpoint)
@@ -118,7 +154,12 @@ func (k *Keeper) addCheckpoint(ctx sdk.Context, key []byte, checkpoint hmTypes.C
 
 // GetCheckpointByNumber to get checkpoint by checkpoint number
 func (k *Keeper) GetCheckpointByNumber(ctx sdk.Context, number uint64) (hmTypes.Checkpoint, error) {
-	store := ctx.KVStore(k.storeKey)
+	return k.GetCheckpointByNumberForChain(ctx, "", number)
+}
+
+// GetCheckpointByNumberForChain to get checkpoint of child chain by checkpoint number
+func (k *Keeper) GetCheckpointByNumberForChain(ctx sdk.Context, borChainID string, number uint64) (hmTypes.Checkpoint, error) {
+	store := k.chainStore(ctx, borChainID)
 	checkpointKey := GetCheckpointKey(number)
 	var _checkpoint hmTypes.Checkpoint
 
@@ -136,7 +177,12 @@ func (k *Keeper) GetCheckpointByNumber(ctx sdk.Context, number uint64) (hmTypes.
 
 // GetCheckpointList returns all checkpoints with params like page and limit
 func (k *Keeper) GetCheckpointList(ctx sdk.Context, page uint64, limit uint64) ([]hmTypes.Checkpoint, error) {
-	store := ctx.KVStore(k.storeKey)
+	return k.GetCheckpointListForChain(ctx, "", page, limit)
+}
+
+// GetCheckpointListForChain returns checkpoints of child chain with params like page and limit
+func (k *Keeper) GetCheckpointListForChain(ctx sdk.Context, borChainID string, page uint64, limit uint64) ([]hmTypes.Checkpoint, error) {
+	store := k.chainStore(ctx, borChainID)
 
 	// create headers
 	var checkpoints []hmTypes.Checkpoint
@@ -162,8 +208,13 @@ func (k *Keeper) GetCheckpointList(ctx sdk.Context, page uint64, limit uint64) (
 
 // GetLastCheckpoint gets last checkpoint, checkpoint number = TotalACKs
 func (k *Keeper) GetLastCheckpoint(ctx sdk.Context) (hmTypes.Checkpoint, error) {
-	store := ctx.KVStore(k.storeKey)
-	acksCount := k.GetACKCount(ctx)
+	return k.GetLastCheckpointForChain(ctx, "")
+}
+
+// GetLastCheckpointForChain gets last checkpoint of child chain
+func (k *Keeper) GetLastCheckpointForChain(ctx sdk.Context, borChainID string) (hmTypes.Checkpoint, error) {
+	store := k.chainStore(ctx, borChainID)
+	acksCount := k.GetACKCountForChain(ctx, borChainID)
 
 	lastCheckpointKey := acksCount
 
@@ -185,6 +236,123 @@ func (k *Keeper) GetLastCheckpoint(ctx sdk.Context) (hmTypes.Checkpoint, error)
 	return _checkpoint, cmn.ErrNoCheckpointFound(k.Codespace())
 }
 
+// GetCheckpointByBorBlock returns checkpoint covering given bor block and its number
+func (k *Keeper) GetCheckpointByBorBlock(ctx sdk.Context, borBlock uint64) (uint64, hmTypes.Checkpoint, error) {
+	return k.GetCheckpointByBorBlockForChain(ctx, "", borBlock)
+}
+
+// GetCheckpointByBorBlockForChain returns checkpoint of child chain covering given bor block and its number
+func (k *Keeper) GetCheckpointByBorBlockForChain(ctx sdk.Context, borChainID string, borBlock uint64) (uint64, hmTypes.Checkpoint, error) {
+	store := k.chainStore(ctx, borChainID)
+
+	// first checkpoint ending at or after bor block
+	iterator := store.Iterator(GetCheckpointByEndBlockKey(borBlock), sdk.PrefixEndBytes(CheckpointByEndBlockKey))
+	defer iterator.Close()
+
+	if !iterator.Valid() {
+		return 0, hmTypes.Checkpoint{}, errors.New("No checkpoint found for bor block")
+	}
+
+	number := binary.BigEndian.Uint64(iterator.Value())
+	checkpoint, err := k.GetCheckpointByNumberForChain(ctx, borChainID, number)
+	if err != nil {
+		return 0, checkpoint, err
+	}
+
+	// bor block is in a gap between checkpoints
+	if checkpoint.StartBlock > borBlock {
+		return 0, hmTypes.Checkpoint{}, errors.New("No checkpoint found for bor block")
+	}
+
+	return number, checkpoint, nil
+}
+
+// GetCheckpointByRootHash returns checkpoint with given root hash and its number
+func (k *Keeper) GetCheckpointByRootHash(ctx sdk.Context, rootHash hmTypes.HeimdallHash) (uint64, hmTypes.Checkpoint, error) {
+	return k.GetCheckpointByRootHashForChain(ctx, "", rootHash)
+}
+
+// GetCheckpointByRootHashForChain returns checkpoint of child chain with given root hash and its number
+func (k *Keeper) GetCheckpointByRootHashForChain(ctx sdk.Context, borChainID string, rootHash hmTypes.HeimdallHash) (uint64, hmTypes.Checkpoint, error) {
+	store := k.chainStore(ctx, borChainID)
+
+	key := GetCheckpointByRootKey(rootHash)
+	if !store.Has(key) {
+		return 0, hmTypes.Checkpoint{}, errors.New("No checkpoint found for root hash")
+	}
+
+	number := binary.BigEndian.Uint64(store.Get(key))
+	checkpoint, err := k.GetCheckpointByNumberForChain(ctx, borChainID, number)
+	return number, checkpoint, err
+}
+
+// MigrateCheckpointIndexes builds checkpoint indexes for checkpoints stored before indexes existed.
+// It runs at upgrade height of running chains and is skipped once stored index version is current.
+// Indexes are kept up to date by AddCheckpoint afterwards, InitGenesis only records index version.
+// Secondary child chains are indexed from their first checkpoint, so only primary chain is migrated.
+func (k *Keeper) MigrateCheckpointIndexes(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	if store.Has(CheckpointIndexVersionKey) && binary.BigEndian.Uint64(store.Get(CheckpointIndexVersionKey)) >= CheckpointIndexVersion {
+		return
+	}
+
+	iterator := sdk.KVStorePrefixIterator(store, CheckpointKey)
+	defer iterator.Close()
+
+	count := 0
+	for ; iterator.Valid(); iterator.Next() {
+		number, err := strconv.ParseUint(string(iterator.Key()[len(CheckpointKey):]), 10, 64)
+		if err != nil {
+			k.Logger(ctx).Error("Invalid checkpoint key", "key", iterator.Key(), "error", err)
+			continue
+		}
+
+		var checkpoint hmTypes.Checkpoint
+		if err := k.cdc.UnmarshalBinaryBare(iterator.Value(), &checkpoint); err != nil {
+			k.Logger(ctx).Error("Error unmarshalling checkpoint", "number", number, "error", err)
+			continue
+		}
+
+		k.setCheckpointIndexes(ctx, number, checkpoint)
+		count++
+	}
+
+	k.setCheckpointIndexVersion(ctx)
+	k.Logger(ctx).Info("Checkpoint indexes built", "checkpoints", count, "version", CheckpointIndexVersion)
+}
+
+// setCheckpointIndexes indexes checkpoint number by end block and root hash
+func (k *Keeper) setCheckpointIndexes(ctx sdk.Context, checkpointNumber uint64, checkpoint hmTypes.Checkpoint) {
+	store := k.chainStore(ctx, checkpoint.BorChainID)
+
+	value := sdk.Uint64ToBigEndian(checkpointNumber)
+	store.Set(GetCheckpointByEndBlockKey(checkpoint.EndBlock), value)
+	store.Set(GetCheckpointByRootKey(checkpoint.RootHash), value)
+}
+
+// setCheckpointIndexVersion marks indexes as built with current version
+func (k *Keeper) setCheckpointIndexVersion(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(CheckpointIndexVersionKey, sdk.Uint64ToBigEndian(CheckpointIndexVersion))
+}
+
+// GetChildChainKey returns store prefix of secondary child chain (length prefixed chain id)
+func GetChildChainKey(borChainID string) []byte {
+	key := append([]byte{}, ChildChainKey...)
+	key = append(key, byte(len(borChainID)))
+	return append(key, []byte(borChainID)...)
+}
+
+// GetCheckpointByEndBlockKey appends prefix to end block (big endian to keep index sorted)
+func GetCheckpointByEndBlockKey(endBlock uint64) []byte {
+	return append(CheckpointByEndBlockKey, sdk.Uint64ToBigEndian(endBlock)...)
+}
+
+// GetCheckpointByRootKey appends prefix to root hash
+func GetCheckpointByRootKey(rootHash hmTypes.HeimdallHash) []byte {
+	return append(CheckpointByRootKey, rootHash.Bytes()...)
+}
+
 // GetCheckpointKey appends prefix to checkpointNumber
 func GetCheckpointKey(checkpointNumber uint64) []byte {
 	checkpointNumberBytes := []byte(strconv.FormatUint(checkpointNumber, 10))
@@ -199,13 +367,24 @@ func (k *Keeper) HasStoreValue(ctx sdk.Context, key []byte) bool {
 
 // FlushCheckpointBuffer flushes Checkpoint Buffer
 func (k *Keeper) FlushCheckpointBuffer(ctx sdk.Context) {
-	store := ctx.KVStore(k.storeKey)
+	k.FlushCheckpointBufferForChain(ctx, "")
+}
+
+// FlushCheckpointBufferForChain flushes Checkpoint Buffer of child chain
+func (k *Keeper) FlushCheckpointBufferForChain(ctx sdk.Context, borChainID string) {
+	store := k.chainStore(ctx, borChainID)
 	store.Delete(BufferCheckpointKey)
+	store.Delete(BufferCheckpointSignaturesKey)
 }
 
 // GetCheckpointFromBuffer gets checkpoint in buffer
 func (k *Keeper) GetCheckpointFromBuffer(ctx sdk.Context) (*hmTypes.Checkpoint, error) {
-	store := ctx.KVStore(k.storeKey)
+	return k.GetCheckpointFromBufferForChain(ctx, "")
+}
+
+// GetCheckpointFromBufferForChain gets checkpoint in buffer of child chain
+func (k *Keeper) GetCheckpointFromBufferForChain(ctx sdk.Context, borChainID string) (*hmTypes.Checkpoint, error) {
+	store := k.chainStore(ctx, borChainID)
 
 	// checkpoint block header
 	var checkpoint hmTypes.Checkpoint
@@ -244,7 +423,12 @@ func (k *Keeper) GetLastNoAck(ctx sdk.Context) uint64 {
 
 // GetCheckpoints get checkpoint all checkpoints
 func (k *Keeper) GetCheckpoints(ctx sdk.Context) []hmTypes.Checkpoint {
-	store := ctx.KVStore(k.storeKey)
+	return k.GetCheckpointsForChain(ctx, "")
+}
+
+// GetCheckpointsForChain get all checkpoints of child chain
+func (k *Keeper) GetCheckpointsForChain(ctx sdk.Context, borChainID string) []hmTypes.Checkpoint {
+	store := k.chainStore(ctx, borChainID)
 	// get checkpoint header iterator
 	iterator := sdk.KVStorePrefixIterator(store, CheckpointKey)
 	defer iterator.Close()
@@ -268,7 +452,12 @@ func (k *Keeper) GetCheckpoints(ctx sdk.Context) []hmTypes.Checkpoint {
 
 // GetACKCount returns current ACK count
 func (k Keeper) GetACKCount(ctx sdk.Context) uint64 {
-	store := ctx.KVStore(k.storeKey)
+	return k.GetACKCountForChain(ctx, "")
+}
+
+// GetACKCountForChain returns current ACK count of child chain
+func (k Keeper) GetACKCountForChain(ctx sdk.Context, borChainID string) uint64 {
+	store := k.chainStore(ctx, borChainID)
 	// check if ack count is there
 	if store.Has(ACKCountKey) {
 		// get current ACK count
@@ -285,7 +474,12 @@ func (k Keeper) GetACKCount(ctx sdk.Context) uint64 {
 
 // UpdateACKCountWithValue updates ACK with value
 func (k Keeper) UpdateACKCountWithValue(ctx sdk.Context, value uint64) {
-	store := ctx.KVStore(k.storeKey)
+	k.UpdateACKCountWithValueForChain(ctx, "", value)
+}
+
+// UpdateACKCountWithValueForChain updates ACK of child chain with value
+func (k Keeper) UpdateACKCountWithValueForChain(ctx sdk.Context, borChainID string, value uint64) {
+	store := k.chainStore(ctx, borChainID)
 
 	// convert
 	ackCount := []byte(strconv.FormatUint(value, 10))
@@ -296,10 +490,15 @@ func (k Keeper) UpdateACKCountWithValue(ctx sdk.Context, value uint64) {
 
 // UpdateACKCount updates ACK count by 1
 func (k Keeper) UpdateACKCount(ctx sdk.Context) {
-	store := ctx.KVStore(k.storeKey)
+	k.UpdateACKCountForChain(ctx, "")
+}
+
+// UpdateACKCountForChain updates ACK count of child chain by 1
+func (k Keeper) UpdateACKCountForChain(ctx sdk.Context, borChainID string) {
+	store := k.chainStore(ctx, borChainID)
 
 	// get current ACK Count
-	ACKCount := k.GetACKCount(ctx)
+	ACKCount := k.GetACKCountForChain(ctx, borChainID)
 
 	// increment by 1
 	ACKs := []byte(strconv.FormatUint(ACKCount+1, 10))
@@ -318,6 +517,470 @@ func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
 
 // GetParams gets the auth module's parameters.
 func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
-	k.paramSpace.GetParamSet(ctx, &params)
+	// params added after genesis keep defaults until set by governance or upgrade
+	params = types.DefaultParams()
+	for _, pair := range params.ParamSetPairs() {
+		k.paramSpace.GetIfExists(ctx, pair.Key, pair.Value)
+	}
+	return
+}
+
+// MigrateParams sets params which are missing in store (added after genesis) to their defaults.
+// It runs once at upgrade height of the chain, InitGenesis sets all params on new chains.
+func (k Keeper) MigrateParams(ctx sdk.Context) {
+	params := types.DefaultParams()
+	for _, pair := range params.ParamSetPairs() {
+		if !k.paramSpace.Has(ctx, pair.Key) {
+			k.paramSpace.Set(ctx, pair.Key, pair.Value)
+		}
+	}
+}
+
+// GetNextCheckpointLength returns length of next checkpoint.
+// In adaptive mode it is derived from bor block rate over recent checkpoints and target checkpoint period.
+func (k Keeper) GetNextCheckpointLength(ctx sdk.Context) uint64 {
+	return k.GetNextCheckpointLengthForChain(ctx, "")
+}
+
+// GetNextCheckpointLengthForChain returns length of next checkpoint of child chain
+func (k Keeper) GetNextCheckpointLengthForChain(ctx sdk.Context, borChainID string) uint64 {
+	params := k.GetParams(ctx)
+	if !params.AdaptiveCheckpointLength {
+		return params.AvgCheckpointLength
+	}
+
+	ackCount := k.GetACKCountForChain(ctx, borChainID)
+	if ackCount < 2 {
+		return params.AvgCheckpointLength
+	}
+
+	window := params.CheckpointLengthWindow
+	if window > ackCount {
+		window = ackCount
+	}
+
+	first, err := k.GetCheckpointByNumberForChain(ctx, borChainID, ackCount-window+1)
+	if err != nil {
+		return params.AvgCheckpointLength
+	}
+
+	last, err := k.GetCheckpointByNumberForChain(ctx, borChainID, ackCount)
+	if err != nil || last.TimeStamp <= first.TimeStamp || last.EndBlock <= first.EndBlock {
+		return params.AvgCheckpointLength
+	}
+
+	// blocks produced per target period at observed rate
+	blocks := last.EndBlock - first.EndBlock
+	elapsed := last.TimeStamp - first.TimeStamp
+	length := blocks * uint64(params.TargetCheckpointPeriod.Seconds()) / elapsed
+
+	if length == 0 {
+		length = 1
+	}
+	if length > params.MaxCheckpointLength {
+		length = params.MaxCheckpointLength
+	}
+	return length
+}
+
+//
+// Checkpoint signatures
+//
+
+// SetCheckpointBufferSignatures stores side-tx data and sigs which approved checkpoint in buffer
+func (k *Keeper) SetCheckpointBufferSignatures(ctx sdk.Context, borChainID string, signatures types.CheckpointSignatures) {
+	store := k.chainStore(ctx, borChainID)
+	store.Set(BufferCheckpointSignaturesKey, k.cdc.MustMarshalBinaryBare(signatures))
+}
+
+// GetCheckpointBufferSignaturesForChain returns side-tx data and sigs of checkpoint in buffer of child chain
+func (k *Keeper) GetCheckpointBufferSignaturesForChain(ctx sdk.Context, borChainID string) (signatures types.CheckpointSignatures, err error) {
+	return k.getCheckpointSignatures(ctx, borChainID, BufferCheckpointSignaturesKey)
+}
+
+// GetCheckpointSignaturesForChain returns side-tx data and sigs of acked checkpoint of child chain
+func (k *Keeper) GetCheckpointSignaturesForChain(ctx sdk.Context, borChainID string, checkpointNumber uint64) (signatures types.CheckpointSignatures, err error) {
+	return k.getCheckpointSignatures(ctx, borChainID, GetCheckpointSignaturesKey(checkpointNumber))
+}
+
+// SetCheckpointSignatures stores side-tx data and sigs of acked checkpoint of child chain
+func (k *Keeper) SetCheckpointSignatures(ctx sdk.Context, borChainID string, checkpointNumber uint64, signatures types.CheckpointSignatures) {
+	store := k.chainStore(ctx, borChainID)
+	store.Set(GetCheckpointSignaturesKey(checkpointNumber), k.cdc.MustMarshalBinaryBare(signatures))
+}
+
+// GetAllCheckpointSignaturesForChain returns side-tx data and sigs of all acked checkpoints of child chain
+func (k *Keeper) GetAllCheckpointSignaturesForChain(ctx sdk.Context, borChainID string) (signatures []types.AckedCheckpointSignatures) {
+	iterator := sdk.KVStorePrefixIterator(k.chainStore(ctx, borChainID), CheckpointSignaturesKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var result types.CheckpointSignatures
+		if err := k.cdc.UnmarshalBinaryBare(iterator.Value(), &result); err != nil {
+			k.Logger(ctx).Error("Error unmarshalling checkpoint signatures", "error", err)
+			continue
+		}
+
+		signatures = append(signatures, types.AckedCheckpointSignatures{
+			Number:     binary.BigEndian.Uint64(iterator.Key()[len(CheckpointSignaturesKey):]),
+			Signatures: result,
+		})
+	}
+
 	return
 }
+
+// AckCheckpointBufferSignatures moves sigs of checkpoint in buffer under acked checkpoint number
+func (k *Keeper) AckCheckpointBufferSignatures(ctx sdk.Context, borChainID string, checkpointNumber uint64) {
+	store := k.chainStore(ctx, borChainID)
+	if bz := store.Get(BufferCheckpointSignaturesKey); bz != nil {
+		store.Set(GetCheckpointSignaturesKey(checkpointNumber), bz)
+		store.Delete(BufferCheckpointSignaturesKey)
+	}
+}
+
+func (k *Keeper) getCheckpointSignatures(ctx sdk.Context, borChainID string, key []byte) (signatures types.CheckpointSignatures, err error) {
+	store := k.chainStore(ctx, borChainID)
+	bz := store.Get(key)
+	if bz == nil {
+		return signatures, errors.New("No signatures found for checkpoint")
+	}
+
+	err = k.cdc.UnmarshalBinaryBare(bz, &signatures)
+	return signatures, err
+}
+
+//
+// No-ack penalties
+//
+
+// GetProposerMissInfo returns no-ack miss window of validator
+func (k *Keeper) GetProposerMissInfo(ctx sdk.Context, valID hmTypes.ValidatorID) types.ProposerMissInfo {
+	missInfo := types.ProposerMissInfo{ValidatorID: valID}
+
+	store := ctx.KVStore(k.storeKey)
+	if bz := store.Get(GetProposerMissInfoKey(valID)); bz != nil {
+		k.cdc.MustUnmarshalBinaryBare(bz, &missInfo)
+	}
+	return missInfo
+}
+
+// updateProposerMisses records if buffered checkpoint of proposer was missed in its rolling window,
+// proposer is fed into slashing buffer once misses go past threshold
+func (k *Keeper) updateProposerMisses(ctx sdk.Context, proposer hmTypes.HeimdallAddress, missed bool) {
+	params := k.GetParams(ctx)
+	if params.NoAckMissWindow == 0 || params.SlashFractionNoAck.IsZero() {
+		return
+	}
+
+	validator, err := k.sk.GetValidatorInfo(ctx, proposer.Bytes())
+	if err != nil {
+		k.Logger(ctx).Debug("Skipping misses of unknown proposer", "proposer", proposer, "error", err)
+		return
+	}
+
+	missInfo := k.GetProposerMissInfo(ctx, validator.ID)
+
+	// bits recorded with another window size don't map to new window, start over
+	if missInfo.Window != params.NoAckMissWindow {
+		missInfo.Window = params.NoAckMissWindow
+		missInfo.IndexOffset = 0
+		missInfo.MissedCounter = 0
+		k.clearProposerMissBitArray(ctx, validator.ID)
+	}
+
+	index := missInfo.IndexOffset % params.NoAckMissWindow
+	missInfo.IndexOffset++
+
+	// update missed counter with the value it replaces in window
+	previous := k.getProposerMissBit(ctx, validator.ID, index)
+	switch {
+	case !previous && missed:
+		k.setProposerMissBit(ctx, validator.ID, index, true)
+		missInfo.MissedCounter++
+	case previous && !missed:
+		k.setProposerMissBit(ctx, validator.ID, index, false)
+		missInfo.MissedCounter--
+	}
+
+	if missInfo.MissedCounter > params.NoAckMissThreshold {
+		slashedAmount := k.moduleCommunicator.SlashInterim(ctx, validator.ID, params.SlashFractionNoAck, hmTypes.InfractionCheckpointNoAck)
+		k.Logger(ctx).Info("Proposer went past no-ack miss threshold",
+			"valID", validator.ID,
+			"missed", missInfo.MissedCounter,
+			"threshold", params.NoAckMissThreshold,
+			"slashedAmount", slashedAmount,
+		)
+
+		// reset window so that proposer is not slashed again for same misses
+		missInfo.IndexOffset = 0
+		missInfo.MissedCounter = 0
+		k.clearProposerMissBitArray(ctx, validator.ID)
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(GetProposerMissInfoKey(validator.ID), k.cdc.MustMarshalBinaryBare(missInfo))
+}
+
+func (k *Keeper) getProposerMissBit(ctx sdk.Context, valID hmTypes.ValidatorID, index uint64) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(GetProposerMissBitArrayKey(valID, index))
+}
+
+func (k *Keeper) setProposerMissBit(ctx sdk.Context, valID hmTypes.ValidatorID, index uint64, missed bool) {
+	store := ctx.KVStore(k.storeKey)
+	if missed {
+		store.Set(GetProposerMissBitArrayKey(valID, index), DefaultValue)
+	} else {
+		store.Delete(GetProposerMissBitArrayKey(valID, index))
+	}
+}
+
+func (k *Keeper) clearProposerMissBitArray(ctx sdk.Context, valID hmTypes.ValidatorID) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, GetProposerMissBitArrayPrefix(valID))
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+//
+// Checkpoint stats
+//
+
+// RecordCheckpointBuffered updates stats of child chain when checkpoint enters the buffer
+func (k *Keeper) RecordCheckpointBuffered(ctx sdk.Context, checkpoint hmTypes.Checkpoint) {
+	stats := k.GetCheckpointStatsForChain(ctx, checkpoint.BorChainID)
+	stats.Buffered++
+	k.setCheckpointStats(ctx, checkpoint.BorChainID, stats)
+
+	k.updateProposerStats(ctx, checkpoint.BorChainID, checkpoint.Proposer, func(proposerStats *types.ProposerStats) {
+		proposerStats.Buffered++
+	})
+}
+
+// RecordCheckpointAcked records lifecycle of acked checkpoint and updates stats of its child chain
+func (k *Keeper) RecordCheckpointAcked(ctx sdk.Context, checkpointNumber uint64, checkpoint hmTypes.Checkpoint) {
+	ackedAt := uint64(ctx.BlockTime().Unix())
+	lifecycle := types.CheckpointLifecycle{
+		Number:     checkpointNumber,
+		Proposer:   checkpoint.Proposer,
+		BufferedAt: checkpoint.TimeStamp,
+		AckedAt:    ackedAt,
+	}
+
+	// checkpoint numbers are per child chain
+	store := k.chainStore(ctx, checkpoint.BorChainID)
+	store.Set(GetCheckpointLifecycleKey(checkpointNumber), k.cdc.MustMarshalBinaryBare(lifecycle))
+
+	k.updateProposerMisses(ctx, checkpoint.Proposer, false)
+
+	var ackTime uint64
+	if ackedAt > checkpoint.TimeStamp {
+		ackTime = ackedAt - checkpoint.TimeStamp
+	}
+
+	stats := k.GetCheckpointStatsForChain(ctx, checkpoint.BorChainID)
+	stats.Acked++
+	stats.TotalAckTime += ackTime
+	k.setCheckpointStats(ctx, checkpoint.BorChainID, stats)
+
+	k.updateProposerStats(ctx, checkpoint.BorChainID, checkpoint.Proposer, func(proposerStats *types.ProposerStats) {
+		proposerStats.Acked++
+		proposerStats.TotalAckTime += ackTime
+	})
+}
+
+// RecordCheckpointFlushed records checkpoint flushed from buffer on timeout and updates stats of its child chain
+func (k *Keeper) RecordCheckpointFlushed(ctx sdk.Context, checkpoint hmTypes.Checkpoint) {
+	stats := k.GetCheckpointStatsForChain(ctx, checkpoint.BorChainID)
+
+	store := k.chainStore(ctx, checkpoint.BorChainID)
+	store.Set(GetFlushedCheckpointKey(stats.Flushed), k.cdc.MustMarshalBinaryBare(types.FlushedCheckpoint{
+		Proposer:   checkpoint.Proposer,
+		StartBlock: checkpoint.StartBlock,
+		EndBlock:   checkpoint.EndBlock,
+		BufferedAt: checkpoint.TimeStamp,
+		FlushedAt:  uint64(ctx.BlockTime().Unix()),
+	}))
+
+	stats.Flushed++
+	k.setCheckpointStats(ctx, checkpoint.BorChainID, stats)
+
+	k.updateProposerStats(ctx, checkpoint.BorChainID, checkpoint.Proposer, func(proposerStats *types.ProposerStats) {
+		proposerStats.Flushed++
+	})
+
+	k.updateProposerMisses(ctx, checkpoint.Proposer, true)
+}
+
+// RecordNoAck records accepted no-ack of child chain, proposer is the one replaced by it
+func (k *Keeper) RecordNoAck(ctx sdk.Context, borChainID string, from hmTypes.HeimdallAddress, proposer hmTypes.HeimdallAddress) {
+	stats := k.GetCheckpointStatsForChain(ctx, borChainID)
+
+	store := k.chainStore(ctx, borChainID)
+	store.Set(GetNoAckRecordKey(stats.NoAcks), k.cdc.MustMarshalBinaryBare(types.NoAckRecord{
+		From:     from,
+		Proposer: proposer,
+		Time:     uint64(ctx.BlockTime().Unix()),
+	}))
+
+	stats.NoAcks++
+	k.setCheckpointStats(ctx, borChainID, stats)
+
+	k.updateProposerStats(ctx, borChainID, proposer, func(proposerStats *types.ProposerStats) {
+		proposerStats.NoAcks++
+	})
+}
+
+// GetCheckpointStats returns checkpoint stats
+func (k *Keeper) GetCheckpointStats(ctx sdk.Context) types.CheckpointStats {
+	return k.GetCheckpointStatsForChain(ctx, "")
+}
+
+// GetCheckpointStatsForChain returns checkpoint stats of child chain
+func (k *Keeper) GetCheckpointStatsForChain(ctx sdk.Context, borChainID string) (stats types.CheckpointStats) {
+	store := k.chainStore(ctx, borChainID)
+	if bz := store.Get(CheckpointStatsKey); bz != nil {
+		k.cdc.MustUnmarshalBinaryBare(bz, &stats)
+	}
+	return stats
+}
+
+// GetProposerStats returns checkpoint stats of validator
+func (k *Keeper) GetProposerStats(ctx sdk.Context, valID hmTypes.ValidatorID) types.ProposerStats {
+	return k.GetProposerStatsForChain(ctx, "", valID)
+}
+
+// GetProposerStatsForChain returns checkpoint stats of validator on child chain
+func (k *Keeper) GetProposerStatsForChain(ctx sdk.Context, borChainID string, valID hmTypes.ValidatorID) types.ProposerStats {
+	stats := types.ProposerStats{ValidatorID: valID}
+
+	store := k.chainStore(ctx, borChainID)
+	if bz := store.Get(GetProposerStatsKey(valID)); bz != nil {
+		k.cdc.MustUnmarshalBinaryBare(bz, &stats)
+	}
+	return stats
+}
+
+// GetCheckpointLifecycle returns lifecycle of acked checkpoint
+func (k *Keeper) GetCheckpointLifecycle(ctx sdk.Context, checkpointNumber uint64) (types.CheckpointLifecycle, error) {
+	return k.GetCheckpointLifecycleForChain(ctx, "", checkpointNumber)
+}
+
+// GetCheckpointLifecycleForChain returns lifecycle of acked checkpoint of child chain
+func (k *Keeper) GetCheckpointLifecycleForChain(ctx sdk.Context, borChainID string, checkpointNumber uint64) (lifecycle types.CheckpointLifecycle, err error) {
+	store := k.chainStore(ctx, borChainID)
+	bz := store.Get(GetCheckpointLifecycleKey(checkpointNumber))
+	if bz == nil {
+		return lifecycle, errors.New("No lifecycle found for checkpoint")
+	}
+
+	err = k.cdc.UnmarshalBinaryBare(bz, &lifecycle)
+	return lifecycle, err
+}
+
+// GetFlushedCheckpoints returns checkpoints flushed from buffer on timeout
+func (k *Keeper) GetFlushedCheckpoints(ctx sdk.Context) []types.FlushedCheckpoint {
+	return k.GetFlushedCheckpointsForChain(ctx, "")
+}
+
+// GetFlushedCheckpointsForChain returns checkpoints of child chain flushed from buffer on timeout
+func (k *Keeper) GetFlushedCheckpointsForChain(ctx sdk.Context, borChainID string) (flushed []types.FlushedCheckpoint) {
+	store := k.chainStore(ctx, borChainID)
+	iterator := sdk.KVStorePrefixIterator(store, FlushedCheckpointKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var checkpoint types.FlushedCheckpoint
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &checkpoint)
+		flushed = append(flushed, checkpoint)
+	}
+	return flushed
+}
+
+// GetNoAckRecords returns accepted no-acks
+func (k *Keeper) GetNoAckRecords(ctx sdk.Context) []types.NoAckRecord {
+	return k.GetNoAckRecordsForChain(ctx, "")
+}
+
+// GetNoAckRecordsForChain returns accepted no-acks of child chain
+func (k *Keeper) GetNoAckRecordsForChain(ctx sdk.Context, borChainID string) (records []types.NoAckRecord) {
+	store := k.chainStore(ctx, borChainID)
+	iterator := sdk.KVStorePrefixIterator(store, NoAckRecordKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var record types.NoAckRecord
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &record)
+		records = append(records, record)
+	}
+	return records
+}
+
+func (k *Keeper) setCheckpointStats(ctx sdk.Context, borChainID string, stats types.CheckpointStats) {
+	store := k.chainStore(ctx, borChainID)
+	store.Set(CheckpointStatsKey, k.cdc.MustMarshalBinaryBare(stats))
+}
+
+// updateProposerStats applies update to child chain stats of validator with given signer, unknown signers are skipped
+func (k *Keeper) updateProposerStats(ctx sdk.Context, borChainID string, proposer hmTypes.HeimdallAddress, update func(*types.ProposerStats)) {
+	validator, err := k.sk.GetValidatorInfo(ctx, proposer.Bytes())
+	if err != nil {
+		k.Logger(ctx).Debug("Skipping stats of unknown proposer", "proposer", proposer, "error", err)
+		return
+	}
+
+	stats := k.GetProposerStatsForChain(ctx, borChainID, validator.ID)
+	update(&stats)
+
+	store := k.chainStore(ctx, borChainID)
+	store.Set(GetProposerStatsKey(validator.ID), k.cdc.MustMarshalBinaryBare(stats))
+}
+
+// GetProposerStatsKey appends prefix to validator id
+func GetProposerStatsKey(valID hmTypes.ValidatorID) []byte {
+	return append(ProposerStatsKey, sdk.Uint64ToBigEndian(valID.Uint64())...)
+}
+
+// GetCheckpointLifecycleKey appends prefix to checkpoint number
+func GetCheckpointLifecycleKey(checkpointNumber uint64) []byte {
+	return append(CheckpointLifecycleKey, sdk.Uint64ToBigEndian(checkpointNumber)...)
+}
+
+// GetFlushedCheckpointKey appends prefix to flushed checkpoint sequence
+func GetFlushedCheckpointKey(sequence uint64) []byte {
+	return append(FlushedCheckpointKey, sdk.Uint64ToBigEndian(sequence)...)
+}
+
+// GetNoAckRecordKey appends prefix to no-ack sequence
+func GetNoAckRecordKey(sequence uint64) []byte {
+	return append(NoAckRecordKey, sdk.Uint64ToBigEndian(sequence)...)
+}
+
+// GetCheckpointSignaturesKey appends prefix to checkpoint number
+func GetCheckpointSignaturesKey(checkpointNumber uint64) []byte {
+	return append(CheckpointSignaturesKey, sdk.Uint64ToBigEndian(checkpointNumber)...)
+}
+
+// GetProposerMissInfoKey appends prefix to validator id
+func GetProposerMissInfoKey(valID hmTypes.ValidatorID) []byte {
+	return append(ProposerMissInfoKey, sdk.Uint64ToBigEndian(valID.Uint64())...)
+}
+
+// GetProposerMissBitArrayPrefix returns prefix of missed checkpoints of validator
+func GetProposerMissBitArrayPrefix(valID hmTypes.ValidatorID) []byte {
+	return append(ProposerMissBitArrayKey, sdk.Uint64ToBigEndian(valID.Uint64())...)
+}
+
+// GetProposerMissBitArrayKey appends index in window to prefix of validator
+func GetProposerMissBitArrayKey(valID hmTypes.ValidatorID, index uint64) []byte {
+	return append(GetProposerMissBitArrayPrefix(valID), sdk.Uint64ToBigEndian(index)...)
+}
@@ -6,7 +6,10 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/maticnetwork/heimdall/app"
+	chainmanagerTypes "github.com/maticnetwork/heimdall/chainmanager/types"
 	"github.com/maticnetwork/heimdall/checkpoint"
+	chSim "github.com/maticnetwork/heimdall/checkpoint/simulation"
+	"github.com/maticnetwork/heimdall/checkpoint/types"
 	hmTypes "github.com/maticnetwork/heimdall/types"
 
 	"github.com/stretchr/testify/require"
@@ -114,3 +117,348 @@ func (suite *KeeperTestSuite) TestFlushCheckpointBuffer() {
 	result := keeper.HasStoreValue(ctx, key)
 	require.False(t, result)
 }
+
+func (suite *KeeperTestSuite) TestGetCheckpointByBorBlock() {
+	t, app, ctx := suite.T(), suite.app, suite.ctx
+	keeper := app.CheckpointKeeper
+
+	// checkpoints for [0, 255], [256, 511] and [1024, 1279]
+	ranges := [][2]uint64{{0, 255}, {256, 511}, {1024, 1279}}
+	for i, r := range ranges {
+		checkpointBlock := hmTypes.CreateBlock(
+			r[0],
+			r[1],
+			hmTypes.BytesToHeimdallHash([]byte{byte(i + 1)}),
+			hmTypes.HexToHeimdallAddress("123"),
+			"1234",
+			uint64(time.Now().Unix()),
+		)
+		require.NoError(t, keeper.AddCheckpoint(ctx, uint64(i+1), checkpointBlock))
+	}
+
+	number, result, err := keeper.GetCheckpointByBorBlock(ctx, 0)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), number)
+	require.Equal(t, uint64(255), result.EndBlock)
+
+	number, result, err = keeper.GetCheckpointByBorBlock(ctx, 256)
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), number)
+	require.Equal(t, uint64(256), result.StartBlock)
+
+	number, _, err = keeper.GetCheckpointByBorBlock(ctx, 1279)
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), number)
+
+	// gap between checkpoints and blocks after last checkpoint
+	_, _, err = keeper.GetCheckpointByBorBlock(ctx, 700)
+	require.Error(t, err)
+	_, _, err = keeper.GetCheckpointByBorBlock(ctx, 1280)
+	require.Error(t, err)
+
+	number, result, err = keeper.GetCheckpointByRootHash(ctx, hmTypes.BytesToHeimdallHash([]byte{2}))
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), number)
+	require.Equal(t, uint64(511), result.EndBlock)
+
+	_, _, err = keeper.GetCheckpointByRootHash(ctx, hmTypes.BytesToHeimdallHash([]byte{4}))
+	require.Error(t, err)
+}
+
+func (suite *KeeperTestSuite) TestMigrateCheckpointIndexes() {
+	t, app, ctx := suite.T(), suite.app, suite.ctx
+	keeper := app.CheckpointKeeper
+
+	rootHash := hmTypes.HexToHeimdallHash("123")
+	checkpointBlock := hmTypes.CreateBlock(
+		0,
+		255,
+		rootHash,
+		hmTypes.HexToHeimdallAddress("123"),
+		"1234",
+		uint64(time.Now().Unix()),
+	)
+	require.NoError(t, keeper.AddCheckpoint(ctx, 1, checkpointBlock))
+
+	// drop indexes and version, as on chains started before indexes existed
+	store := ctx.KVStore(app.GetKey(types.StoreKey))
+	store.Delete(checkpoint.GetCheckpointByEndBlockKey(255))
+	store.Delete(checkpoint.GetCheckpointByRootKey(rootHash))
+	store.Delete(checkpoint.CheckpointIndexVersionKey)
+
+	_, _, err := keeper.GetCheckpointByBorBlock(ctx, 100)
+	require.Error(t, err)
+
+	keeper.MigrateCheckpointIndexes(ctx)
+	require.True(t, store.Has(checkpoint.CheckpointIndexVersionKey))
+
+	number, _, err := keeper.GetCheckpointByBorBlock(ctx, 100)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), number)
+
+	number, _, err = keeper.GetCheckpointByRootHash(ctx, rootHash)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), number)
+}
+
+func (suite *KeeperTestSuite) TestCheckpointStats() {
+	t, app, ctx := suite.T(), suite.app, suite.ctx
+	keeper := app.CheckpointKeeper
+
+	chSim.LoadValidatorSet(2, t, app.StakingKeeper, ctx, false, 10)
+	proposer := app.StakingKeeper.GetValidatorSet(ctx).Validators[0]
+
+	checkpointBlock := hmTypes.CreateBlock(
+		0,
+		255,
+		hmTypes.HexToHeimdallHash("123"),
+		proposer.Signer,
+		"1234",
+		uint64(1000),
+	)
+	keeper.RecordCheckpointBuffered(ctx, checkpointBlock)
+	keeper.RecordCheckpointFlushed(ctx, checkpointBlock)
+	keeper.RecordCheckpointBuffered(ctx, checkpointBlock)
+	keeper.RecordCheckpointAcked(ctx.WithBlockTime(time.Unix(1100, 0)), 1, checkpointBlock)
+
+	lifecycle, err := keeper.GetCheckpointLifecycle(ctx, 1)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1000), lifecycle.BufferedAt)
+	require.Equal(t, uint64(1100), lifecycle.AckedAt)
+	require.Equal(t, proposer.Signer, lifecycle.Proposer)
+
+	_, err = keeper.GetCheckpointLifecycle(ctx, 2)
+	require.Error(t, err)
+
+	stats := keeper.GetCheckpointStats(ctx)
+	require.Equal(t, uint64(2), stats.Buffered)
+	require.Equal(t, uint64(1), stats.Acked)
+	require.Equal(t, uint64(1), stats.Flushed)
+	require.Equal(t, uint64(100), stats.AvgAckTime())
+
+	proposerStats := keeper.GetProposerStats(ctx, proposer.ID)
+	require.Equal(t, proposer.ID, proposerStats.ValidatorID)
+	require.Equal(t, uint64(1), proposerStats.Acked)
+	require.Equal(t, 0.5, proposerStats.SuccessRate())
+
+	// unknown proposers only count towards global stats
+	checkpointBlock.Proposer = hmTypes.HexToHeimdallAddress("456")
+	keeper.RecordCheckpointBuffered(ctx, checkpointBlock)
+	require.Equal(t, uint64(3), keeper.GetCheckpointStats(ctx).Buffered)
+	require.Equal(t, uint64(2), keeper.GetProposerStats(ctx, proposer.ID).Buffered)
+}
+
+func (suite *KeeperTestSuite) TestProposerMisses() {
+	t, app, ctx := suite.T(), suite.app, suite.ctx
+	keeper := app.CheckpointKeeper
+
+	chSim.LoadValidatorSet(2, t, app.StakingKeeper, ctx, false, 10)
+	proposer := app.StakingKeeper.GetValidatorSet(ctx).Validators[0]
+
+	slashingParams := app.SlashingKeeper.GetParams(ctx)
+	slashingParams.EnableSlashing = true
+	app.SlashingKeeper.SetParams(ctx, slashingParams)
+
+	checkpointBlock := hmTypes.CreateBlock(
+		0,
+		255,
+		hmTypes.HexToHeimdallHash("123"),
+		proposer.Signer,
+		"1234",
+		uint64(1000),
+	)
+
+	// misses are not tracked by default, governance enables it
+	keeper.RecordCheckpointFlushed(ctx, checkpointBlock)
+	require.Equal(t, uint64(0), keeper.GetProposerMissInfo(ctx, proposer.ID).IndexOffset)
+
+	params := keeper.GetParams(ctx)
+	params.NoAckMissWindow = 3
+	params.NoAckMissThreshold = 1
+	params.SlashFractionNoAck = sdk.NewDecWithPrec(1, 1)
+	keeper.SetParams(ctx, params)
+
+	keeper.RecordCheckpointFlushed(ctx, checkpointBlock)
+	keeper.RecordCheckpointAcked(ctx, 1, checkpointBlock)
+
+	missInfo := keeper.GetProposerMissInfo(ctx, proposer.ID)
+	require.Equal(t, uint64(2), missInfo.IndexOffset)
+	require.Equal(t, uint64(1), missInfo.MissedCounter)
+
+	_, found := app.SlashingKeeper.GetBufferValSlashingInfo(ctx, proposer.ID)
+	require.False(t, found)
+
+	// second miss in window goes past threshold
+	keeper.RecordCheckpointFlushed(ctx, checkpointBlock)
+
+	missInfo = keeper.GetProposerMissInfo(ctx, proposer.ID)
+	require.Equal(t, uint64(0), missInfo.IndexOffset)
+	require.Equal(t, uint64(0), missInfo.MissedCounter)
+
+	slashingInfo, found := app.SlashingKeeper.GetBufferValSlashingInfo(ctx, proposer.ID)
+	require.True(t, found)
+	require.Equal(t, uint64(proposer.VotingPower/10), slashingInfo.SlashedAmount)
+	require.Equal(t, []string{hmTypes.InfractionCheckpointNoAck}, slashingInfo.Infractions)
+
+	// infraction is carried into tick slashing info
+	require.NoError(t, app.SlashingKeeper.CopyBufferValSlashingInfosToTickData(ctx))
+	tickSlashingInfo, found := app.SlashingKeeper.GetTickValSlashingInfo(ctx, proposer.ID)
+	require.True(t, found)
+	require.Equal(t, []string{hmTypes.InfractionCheckpointNoAck}, tickSlashingInfo.Infractions)
+
+	// misses are forgotten once they leave the window
+	keeper.RecordCheckpointFlushed(ctx, checkpointBlock)
+	keeper.RecordCheckpointAcked(ctx, 2, checkpointBlock)
+	keeper.RecordCheckpointAcked(ctx, 3, checkpointBlock)
+	keeper.RecordCheckpointAcked(ctx, 4, checkpointBlock)
+
+	missInfo = keeper.GetProposerMissInfo(ctx, proposer.ID)
+	require.Equal(t, uint64(0), missInfo.MissedCounter)
+
+	// misses recorded with previous window are dropped when window changes
+	keeper.RecordCheckpointFlushed(ctx, checkpointBlock)
+	missInfo = keeper.GetProposerMissInfo(ctx, proposer.ID)
+	require.Equal(t, uint64(1), missInfo.MissedCounter)
+
+	params.NoAckMissWindow = 5
+	keeper.SetParams(ctx, params)
+
+	keeper.RecordCheckpointAcked(ctx, 5, checkpointBlock)
+	missInfo = keeper.GetProposerMissInfo(ctx, proposer.ID)
+	require.Equal(t, uint64(5), missInfo.Window)
+	require.Equal(t, uint64(1), missInfo.IndexOffset)
+	require.Equal(t, uint64(0), missInfo.MissedCounter)
+
+	// single miss in new window doesn't go past threshold
+	keeper.RecordCheckpointFlushed(ctx, checkpointBlock)
+	missInfo = keeper.GetProposerMissInfo(ctx, proposer.ID)
+	require.Equal(t, uint64(2), missInfo.IndexOffset)
+	require.Equal(t, uint64(1), missInfo.MissedCounter)
+}
+
+func (suite *KeeperTestSuite) TestGetNextCheckpointLength() {
+	t, app, ctx := suite.T(), suite.app, suite.ctx
+	keeper := app.CheckpointKeeper
+
+	params := keeper.GetParams(ctx)
+	require.False(t, params.AdaptiveCheckpointLength)
+	require.Equal(t, params.AvgCheckpointLength, keeper.GetNextCheckpointLength(ctx))
+
+	params.AdaptiveCheckpointLength = true
+	params.TargetCheckpointPeriod = 10 * time.Minute
+	params.CheckpointLengthWindow = 3
+	keeper.SetParams(ctx, params)
+
+	// not enough checkpoints
+	require.Equal(t, params.AvgCheckpointLength, keeper.GetNextCheckpointLength(ctx))
+
+	// 256 blocks every 256 seconds in window, older checkpoint is outside window
+	checkpoints := [][3]uint64{{0, 255, 0}, {256, 511, 1000}, {512, 767, 1256}, {768, 1023, 1512}}
+	for i, c := range checkpoints {
+		checkpointBlock := hmTypes.CreateBlock(c[0], c[1], hmTypes.HexToHeimdallHash("123"), hmTypes.HexToHeimdallAddress("123"), "1234", c[2])
+		require.NoError(t, keeper.AddCheckpoint(ctx, uint64(i+1), checkpointBlock))
+		keeper.UpdateACKCount(ctx)
+	}
+
+	// 1 block per second for 10 minutes
+	require.Equal(t, uint64(600), keeper.GetNextCheckpointLength(ctx))
+
+	// bounded by max checkpoint length
+	params.TargetCheckpointPeriod = time.Hour
+	keeper.SetParams(ctx, params)
+	require.Equal(t, params.MaxCheckpointLength, keeper.GetNextCheckpointLength(ctx))
+}
+
+func (suite *KeeperTestSuite) TestChildChainCheckpoints() {
+	t, app, ctx := suite.T(), suite.app, suite.ctx
+	keeper := app.CheckpointKeeper
+
+	// register secondary child chain
+	chainParams := app.ChainKeeper.GetParams(ctx)
+	chainParams.ChildChains = []chainmanagerTypes.ChildChainParams{
+		{
+			BorChainID:           "80002",
+			RootChainAddress:     hmTypes.HexToHeimdallAddress("0x0000000000000000000000000000000000002000"),
+			StateReceiverAddress: chainmanagerTypes.DefaultStateReceiverAddress,
+			ValidatorSetAddress:  chainmanagerTypes.DefaultValidatorSetAddress,
+		},
+	}
+	app.ChainKeeper.SetParams(ctx, chainParams)
+
+	primaryChainID := chainParams.ChainParams.BorChainID
+	proposer := hmTypes.HexToHeimdallAddress("123")
+	timestamp := uint64(time.Now().Unix())
+
+	primaryCheckpoint := hmTypes.CreateBlock(0, 255, hmTypes.HexToHeimdallHash("111"), proposer, primaryChainID, timestamp)
+	childCheckpoint := hmTypes.CreateBlock(0, 127, hmTypes.HexToHeimdallHash("222"), proposer, "80002", timestamp)
+
+	require.NoError(t, keeper.AddCheckpoint(ctx, 1, primaryCheckpoint))
+	keeper.UpdateACKCount(ctx)
+	require.NoError(t, keeper.AddCheckpoint(ctx, 1, childCheckpoint))
+	keeper.UpdateACKCountForChain(ctx, "80002")
+	keeper.UpdateACKCountForChain(ctx, "80002")
+
+	// checkpoints with same number are kept per chain
+	result, err := keeper.GetCheckpointByNumber(ctx, 1)
+	require.NoError(t, err)
+	require.Equal(t, primaryCheckpoint, result)
+
+	result, err = keeper.GetCheckpointByNumberForChain(ctx, "80002", 1)
+	require.NoError(t, err)
+	require.Equal(t, childCheckpoint, result)
+
+	// ack counts are kept per chain
+	require.Equal(t, uint64(1), keeper.GetACKCount(ctx))
+	require.Equal(t, uint64(2), keeper.GetACKCountForChain(ctx, "80002"))
+
+	// indexes are kept per chain
+	number, result, err := keeper.GetCheckpointByRootHashForChain(ctx, "80002", childCheckpoint.RootHash)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), number)
+	require.Equal(t, childCheckpoint, result)
+
+	_, _, err = keeper.GetCheckpointByRootHash(ctx, childCheckpoint.RootHash)
+	require.Error(t, err)
+
+	// buffers are kept per chain
+	require.NoError(t, keeper.SetCheckpointBuffer(ctx, childCheckpoint))
+	_, err = keeper.GetCheckpointFromBuffer(ctx)
+	require.Error(t, err)
+
+	buffered, err := keeper.GetCheckpointFromBufferForChain(ctx, "80002")
+	require.NoError(t, err)
+	require.Equal(t, childCheckpoint, *buffered)
+
+	keeper.FlushCheckpointBufferForChain(ctx, "80002")
+	_, err = keeper.GetCheckpointFromBufferForChain(ctx, "80002")
+	require.Error(t, err)
+
+	// lifecycles are kept per chain
+	keeper.RecordCheckpointAcked(ctx, 2, childCheckpoint)
+	lifecycle, err := keeper.GetCheckpointLifecycleForChain(ctx, "80002", 2)
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), lifecycle.Number)
+
+	_, err = keeper.GetCheckpointLifecycle(ctx, 2)
+	require.Error(t, err)
+
+	// stats, flushed checkpoints and no-acks are kept per chain
+	keeper.RecordCheckpointFlushed(ctx, childCheckpoint)
+	keeper.RecordNoAck(ctx, "80002", proposer, proposer)
+
+	childStats := keeper.GetCheckpointStatsForChain(ctx, "80002")
+	require.Equal(t, uint64(1), childStats.Acked)
+	require.Equal(t, uint64(1), childStats.Flushed)
+	require.Equal(t, uint64(1), childStats.NoAcks)
+	require.Len(t, keeper.GetFlushedCheckpointsForChain(ctx, "80002"), 1)
+	require.Len(t, keeper.GetNoAckRecordsForChain(ctx, "80002"), 1)
+
+	require.Equal(t, types.CheckpointStats{}, keeper.GetCheckpointStats(ctx))
+	require.Empty(t, keeper.GetFlushedCheckpoints(ctx))
+	require.Empty(t, keeper.GetNoAckRecords(ctx))
+
+	// unregistered chain id falls back to primary chain
+	result, err = keeper.GetCheckpointByNumberForChain(ctx, "1234", 1)
+	require.NoError(t, err)
+	require.Equal(t, primaryCheckpoint, result)
+}
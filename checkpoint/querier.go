@@ -1,6 +1,7 @@
 package checkpoint
 
 import (
+	"bytes"
 	"encoding/json"
 	"fmt"
 
@@ -30,6 +31,22 @@ func NewQuerier(keeper Keeper, stakingKeeper staking.Keeper, topupKeeper topup.K
 			return handleQueryLastNoAck(ctx, req, keeper)
 		case types.QueryCheckpointList:
 			return handleQueryCheckpointList(ctx, req, keeper)
+		case types.QueryCheckpointByBlock:
+			return handleQueryCheckpointByBlock(ctx, req, keeper)
+		case types.QueryCheckpointByRoot:
+			return handleQueryCheckpointByRoot(ctx, req, keeper)
+		case types.QueryCheckpointProof:
+			return handleQueryCheckpointProof(ctx, req, keeper, contractCaller)
+		case types.QueryStats:
+			return handleQueryStats(ctx, req, keeper)
+		case types.QueryProposerStats:
+			return handleQueryProposerStats(ctx, req, keeper)
+		case types.QueryCheckpointLength:
+			return handleQueryCheckpointLength(ctx, req, keeper)
+		case types.QuerySignatures:
+			return handleQuerySignatures(ctx, req, keeper)
+		case types.QueryBufferSignatures:
+			return handleQueryBufferSignatures(ctx, req, keeper)
 		case types.QueryNextCheckpoint:
 			return handleQueryNextCheckpoint(ctx, req, keeper, stakingKeeper, topupKeeper, contractCaller)
 		default:
@@ -46,8 +63,26 @@ func handleQueryParams(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([
 	return bz, nil
 }
 
+// queryBorChainID returns chain id from optional query data, empty chain id (primary chain) without data
+func queryBorChainID(req abci.RequestQuery, keeper Keeper) (string, sdk.Error) {
+	if len(req.Data) == 0 {
+		return "", nil
+	}
+
+	var params types.QueryBorChainID
+	if err := keeper.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return "", sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+	return params.BorChainID, nil
+}
+
 func handleQueryAckCount(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
-	bz, err := json.Marshal(keeper.GetACKCount(ctx))
+	borChainID, sdkErr := queryBorChainID(req, keeper)
+	if sdkErr != nil {
+		return nil, sdkErr
+	}
+
+	bz, err := json.Marshal(keeper.GetACKCountForChain(ctx, borChainID))
 	if err != nil {
 		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
 	}
@@ -60,7 +95,7 @@ func handleQueryCheckpoint(ctx sdk.Context, req abci.RequestQuery, keeper Keeper
 		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
 	}
 
-	res, err := keeper.GetCheckpointByNumber(ctx, params.Number)
+	res, err := keeper.GetCheckpointByNumberForChain(ctx, params.BorChainID, params.Number)
 	if err != nil {
 		return nil, sdk.ErrInternal(sdk.AppendMsgToErr(fmt.Sprintf("could not fetch checkpoint by index %v", params.Number), err.Error()))
 	}
@@ -72,8 +107,131 @@ func handleQueryCheckpoint(ctx sdk.Context, req abci.RequestQuery, keeper Keeper
 	return bz, nil
 }
 
+func handleQueryCheckpointByBlock(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	var params types.QueryBorBlockParams
+	if err := keeper.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+
+	number, checkpoint, err := keeper.GetCheckpointByBorBlockForChain(ctx, params.BorChainID, params.BorBlock)
+	if err != nil {
+		return nil, common.ErrNoCheckpointFound(keeper.Codespace())
+	}
+
+	bz, err := json.Marshal(types.CheckpointWithNumber{Number: number, Checkpoint: checkpoint})
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
+
+func handleQueryCheckpointByRoot(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	var params types.QueryRootHashParams
+	if err := keeper.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+
+	number, checkpoint, err := keeper.GetCheckpointByRootHashForChain(ctx, params.BorChainID, params.RootHash)
+	if err != nil {
+		return nil, common.ErrNoCheckpointFound(keeper.Codespace())
+	}
+
+	bz, err := json.Marshal(types.CheckpointWithNumber{Number: number, Checkpoint: checkpoint})
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
+
+func handleQueryCheckpointProof(ctx sdk.Context, req abci.RequestQuery, keeper Keeper, contractCaller helper.IContractCaller) ([]byte, sdk.Error) {
+	var params types.QueryBorBlockParams
+	if err := keeper.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+
+	number, checkpoint, err := keeper.GetCheckpointByBorBlockForChain(ctx, params.BorChainID, params.BorBlock)
+	if err != nil {
+		return nil, common.ErrNoCheckpointFound(keeper.Codespace())
+	}
+
+	contractCaller, err = getChildChainCaller(ctx, keeper, params.BorChainID, contractCaller)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not get child chain caller", err.Error()))
+	}
+
+	headers, err := contractCaller.GetMaticChainHeaders(checkpoint.StartBlock, checkpoint.EndBlock)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr(fmt.Sprintf("could not fetch headers for start:%v end:%v", checkpoint.StartBlock, checkpoint.EndBlock), err.Error()))
+	}
+
+	proof, index, rootHash, err := types.GetBlockProof(headers, params.BorBlock)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not generate block proof", err.Error()))
+	}
+
+	// headers fetched from bor must match the checkpoint
+	if !bytes.Equal(rootHash, checkpoint.RootHash.Bytes()) {
+		return nil, sdk.ErrInternal(fmt.Sprintf("root hash mismatch for checkpoint %v, expected %v, got %v", number, checkpoint.RootHash, hmTypes.BytesToHeimdallHash(rootHash)))
+	}
+
+	bz, err := json.Marshal(types.BlockProof{
+		BlockNumber:      params.BorBlock,
+		HeaderHash:       hmTypes.BytesToHeimdallHash(types.GetBlockHeaderHash(headers[index])),
+		Index:            index,
+		Proof:            proof,
+		CheckpointNumber: number,
+		Checkpoint:       checkpoint,
+	})
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
+
+func handleQueryStats(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	borChainID, sdkErr := queryBorChainID(req, keeper)
+	if sdkErr != nil {
+		return nil, sdkErr
+	}
+
+	stats := keeper.GetCheckpointStatsForChain(ctx, borChainID)
+
+	bz, err := json.Marshal(types.CheckpointStatsResponse{
+		CheckpointStats: stats,
+		AvgAckTime:      stats.AvgAckTime(),
+	})
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
+
+func handleQueryProposerStats(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	var params types.QueryProposerStatsParams
+	if err := keeper.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+
+	stats := keeper.GetProposerStatsForChain(ctx, params.BorChainID, params.ValidatorID)
+
+	bz, err := json.Marshal(types.ProposerStatsResponse{
+		ProposerStats: stats,
+		AvgAckTime:    stats.AvgAckTime(),
+		SuccessRate:   stats.SuccessRate(),
+	})
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
+
 func handleQueryCheckpointBuffer(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
-	res, err := keeper.GetCheckpointFromBuffer(ctx)
+	borChainID, sdkErr := queryBorChainID(req, keeper)
+	if sdkErr != nil {
+		return nil, sdkErr
+	}
+
+	res, err := keeper.GetCheckpointFromBufferForChain(ctx, borChainID)
 	if err != nil {
 		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not fetch checkpoint buffer", err.Error()))
 	}
@@ -89,6 +247,42 @@ func handleQueryCheckpointBuffer(ctx sdk.Context, req abci.RequestQuery, keeper
 	return bz, nil
 }
 
+func handleQuerySignatures(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	var params types.QueryCheckpointParams
+	if err := keeper.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+
+	res, err := keeper.GetCheckpointSignaturesForChain(ctx, params.BorChainID, params.Number)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr(fmt.Sprintf("could not fetch signatures of checkpoint %v", params.Number), err.Error()))
+	}
+
+	bz, err := json.Marshal(res)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
+
+func handleQueryBufferSignatures(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	borChainID, sdkErr := queryBorChainID(req, keeper)
+	if sdkErr != nil {
+		return nil, sdkErr
+	}
+
+	res, err := keeper.GetCheckpointBufferSignaturesForChain(ctx, borChainID)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not fetch signatures of checkpoint buffer", err.Error()))
+	}
+
+	bz, err := json.Marshal(res)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
+
 func handleQueryLastNoAck(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
 	// get last no ack
 	res := keeper.GetLastNoAck(ctx)
@@ -101,12 +295,12 @@ func handleQueryLastNoAck(ctx sdk.Context, req abci.RequestQuery, keeper Keeper)
 }
 
 func handleQueryCheckpointList(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
-	var params hmTypes.QueryPaginationParams
+	var params types.QueryCheckpointListParams
 	if err := keeper.cdc.UnmarshalJSON(req.Data, &params); err != nil {
 		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
 	}
 
-	res, err := keeper.GetCheckpointList(ctx, params.Page, params.Limit)
+	res, err := keeper.GetCheckpointListForChain(ctx, params.BorChainID, params.Page, params.Limit)
 	if err != nil {
 		return nil, sdk.ErrInternal(sdk.AppendMsgToErr(fmt.Sprintf("could not fetch checkpoint list with page %v and limit %v", params.Page, params.Limit), err.Error()))
 	}
@@ -118,6 +312,22 @@ func handleQueryCheckpointList(ctx sdk.Context, req abci.RequestQuery, keeper Ke
 	return bz, nil
 }
 
+func handleQueryCheckpointLength(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	borChainID, sdkErr := queryBorChainID(req, keeper)
+	if sdkErr != nil {
+		return nil, sdkErr
+	}
+
+	bz, err := json.Marshal(types.CheckpointLength{
+		Length:   keeper.GetNextCheckpointLengthForChain(ctx, borChainID),
+		Adaptive: keeper.GetParams(ctx).AdaptiveCheckpointLength,
+	})
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
+
 func handleQueryNextCheckpoint(ctx sdk.Context, req abci.RequestQuery, keeper Keeper, sk staking.Keeper, tk topup.Keeper, contractCaller helper.IContractCaller) ([]byte, sdk.Error) {
 	var queryParams types.QueryBorChainID
 	if err := keeper.cdc.UnmarshalJSON(req.Data, &queryParams); err != nil {
@@ -127,21 +337,31 @@ func handleQueryNextCheckpoint(ctx sdk.Context, req abci.RequestQuery, keeper Ke
 	// get validator set
 	validatorSet := sk.GetValidatorSet(ctx)
 	proposer := validatorSet.GetProposer()
-	ackCount := keeper.GetACKCount(ctx)
+	ackCount := keeper.GetACKCountForChain(ctx, queryParams.BorChainID)
 	params := keeper.GetParams(ctx)
 
 	var start uint64
 
 	if ackCount != 0 {
 		checkpointNumber := ackCount
-		lastCheckpoint, err := keeper.GetCheckpointByNumber(ctx, checkpointNumber)
+		lastCheckpoint, err := keeper.GetCheckpointByNumberForChain(ctx, queryParams.BorChainID, checkpointNumber)
 		if err != nil {
 			return nil, sdk.ErrInternal(sdk.AppendMsgToErr(fmt.Sprintf("could not fetch checkpoint by index %v", checkpointNumber), err.Error()))
 		}
 		start = lastCheckpoint.EndBlock + 1
 	}
 
-	end := start + params.AvgCheckpointLength
+	end := start + keeper.GetNextCheckpointLengthForChain(ctx, queryParams.BorChainID)
+
+	// cap with max checkpoint length
+	if end-start+1 > params.MaxCheckpointLength {
+		end = start + params.MaxCheckpointLength - 1
+	}
+
+	contractCaller, err := getChildChainCaller(ctx, keeper, queryParams.BorChainID, contractCaller)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not get child chain caller", err.Error()))
+	}
 
 	rootHash, err := contractCaller.GetRootHash(start, end, params.MaxCheckpointLength)
 	if err != nil {
@@ -157,7 +377,7 @@ func handleQueryNextCheckpoint(ctx sdk.Context, req abci.RequestQuery, keeper Ke
 	checkpointMsg := types.NewMsgCheckpointBlock(
 		proposer.Signer,
 		start,
-		start+params.AvgCheckpointLength,
+		end,
 		hmTypes.BytesToHeimdallHash(rootHash),
 		hmTypes.BytesToHeimdallHash(accRootHash),
 		queryParams.BorChainID,
@@ -10,7 +10,10 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/client/context"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	ethCommon "github.com/maticnetwork/bor/common"
+	ethTypes "github.com/maticnetwork/bor/core/types"
 	"github.com/maticnetwork/heimdall/app"
+	chainmanagerTypes "github.com/maticnetwork/heimdall/chainmanager/types"
 	"github.com/maticnetwork/heimdall/checkpoint"
 	chSim "github.com/maticnetwork/heimdall/checkpoint/simulation"
 	"github.com/maticnetwork/heimdall/checkpoint/types"
@@ -134,7 +137,7 @@ func (suite *QuerierTestSuite) TestQueryCheckpoint() {
 
 	req := abci.RequestQuery{
 		Path: route,
-		Data: app.Codec().MustMarshalJSON(types.NewQueryCheckpointParams(headerNumber)),
+		Data: app.Codec().MustMarshalJSON(types.NewQueryCheckpointParams(headerNumber, "")),
 	}
 
 	res, err := querier(ctx, path, req)
@@ -308,3 +311,167 @@ func (suite *QuerierTestSuite) TestQueryNextCheckpoint() {
 	require.Equal(t, checkpointBlock.RootHash, actualRes.RootHash)
 	require.Equal(t, checkpointBlock.BorChainID, actualRes.BorChainID)
 }
+
+func (suite *QuerierTestSuite) TestQueryCheckpointLength() {
+	t, app, ctx, querier := suite.T(), suite.app, suite.ctx, suite.querier
+
+	res, err := querier(ctx, []string{types.QueryCheckpointLength}, abci.RequestQuery{})
+	require.NoError(t, err)
+
+	var length types.CheckpointLength
+	require.NoError(t, json.Unmarshal(res, &length))
+	require.False(t, length.Adaptive)
+	require.Equal(t, app.CheckpointKeeper.GetParams(ctx).AvgCheckpointLength, length.Length)
+}
+
+func (suite *QuerierTestSuite) TestQueryCheckpointByBlock() {
+	t, app, ctx, querier := suite.T(), suite.app, suite.ctx, suite.querier
+
+	rootHash := hmTypes.HexToHeimdallHash("123")
+	checkpointBlock := hmTypes.CreateBlock(
+		256,
+		511,
+		rootHash,
+		hmTypes.HexToHeimdallAddress("123"),
+		"1234",
+		uint64(time.Now().Unix()),
+	)
+	app.CheckpointKeeper.AddCheckpoint(ctx, 2, checkpointBlock)
+
+	path := []string{types.QueryCheckpointByBlock}
+	route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryCheckpointByBlock)
+
+	req := abci.RequestQuery{
+		Path: route,
+		Data: app.Codec().MustMarshalJSON(types.NewQueryBorBlockParams(300, "")),
+	}
+
+	res, err := querier(ctx, path, req)
+	require.NoError(t, err)
+
+	var result types.CheckpointWithNumber
+	require.NoError(t, json.Unmarshal(res, &result))
+	require.Equal(t, uint64(2), result.Number)
+	require.Equal(t, checkpointBlock, result.Checkpoint)
+
+	// block before checkpoint
+	req.Data = app.Codec().MustMarshalJSON(types.NewQueryBorBlockParams(100, ""))
+	_, err = querier(ctx, path, req)
+	require.Error(t, err)
+
+	// by root hash
+	path = []string{types.QueryCheckpointByRoot}
+	req = abci.RequestQuery{
+		Path: fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryCheckpointByRoot),
+		Data: app.Codec().MustMarshalJSON(types.NewQueryRootHashParams(rootHash, "")),
+	}
+
+	res, err = querier(ctx, path, req)
+	require.NoError(t, err)
+	require.NoError(t, json.Unmarshal(res, &result))
+	require.Equal(t, uint64(2), result.Number)
+	require.Equal(t, checkpointBlock, result.Checkpoint)
+}
+
+func (suite *QuerierTestSuite) TestQueryCheckpointProof() {
+	t, app, ctx, querier := suite.T(), suite.app, suite.ctx, suite.querier
+
+	var headers []*ethTypes.Header
+	for number := int64(256); number <= 511; number++ {
+		headers = append(headers, &ethTypes.Header{
+			Number: big.NewInt(number),
+			Time:   uint64(number),
+			TxHash: ethCommon.BigToHash(big.NewInt(number)),
+		})
+	}
+
+	_, _, rootHash, err := types.GetBlockProof(headers, 256)
+	require.NoError(t, err)
+
+	checkpointBlock := hmTypes.CreateBlock(
+		256,
+		511,
+		hmTypes.BytesToHeimdallHash(rootHash),
+		hmTypes.HexToHeimdallAddress("123"),
+		"1234",
+		uint64(time.Now().Unix()),
+	)
+	app.CheckpointKeeper.AddCheckpoint(ctx, 2, checkpointBlock)
+	suite.contractCaller.On("GetMaticChainHeaders", uint64(256), uint64(511)).Return(headers, nil)
+
+	path := []string{types.QueryCheckpointProof}
+	route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryCheckpointProof)
+
+	req := abci.RequestQuery{
+		Path: route,
+		Data: app.Codec().MustMarshalJSON(types.NewQueryBorBlockParams(300, "")),
+	}
+
+	res, err := querier(ctx, path, req)
+	require.NoError(t, err)
+
+	var proof types.BlockProof
+	require.NoError(t, json.Unmarshal(res, &proof))
+	require.Equal(t, uint64(2), proof.CheckpointNumber)
+	require.Equal(t, uint64(44), proof.Index)
+	require.Len(t, proof.Proof, 8*32)
+	require.Equal(t, types.GetBlockHeaderHash(headers[44]), proof.HeaderHash.Bytes())
+	require.True(t, types.VerifyBlockProof(proof.HeaderHash.Bytes(), proof.Index, proof.Proof, checkpointBlock.RootHash.Bytes()))
+
+	// block not checkpointed
+	req.Data = app.Codec().MustMarshalJSON(types.NewQueryBorBlockParams(600, ""))
+	_, err = querier(ctx, path, req)
+	require.Error(t, err)
+}
+
+func (suite *QuerierTestSuite) TestQueryStats() {
+	t, app, ctx, querier := suite.T(), suite.app, suite.ctx, suite.querier
+
+	chSim.LoadValidatorSet(2, t, app.StakingKeeper, ctx, false, 10)
+	proposer := app.StakingKeeper.GetValidatorSet(ctx).Validators[0]
+
+	checkpointBlock := hmTypes.CreateBlock(0, 255, hmTypes.HexToHeimdallHash("123"), proposer.Signer, "1234", uint64(1000))
+	app.CheckpointKeeper.RecordCheckpointBuffered(ctx, checkpointBlock)
+	app.CheckpointKeeper.RecordCheckpointAcked(ctx.WithBlockTime(time.Unix(1060, 0)), 1, checkpointBlock)
+
+	res, err := querier(ctx, []string{types.QueryStats}, abci.RequestQuery{})
+	require.NoError(t, err)
+
+	var stats types.CheckpointStatsResponse
+	require.NoError(t, json.Unmarshal(res, &stats))
+	require.Equal(t, uint64(1), stats.Acked)
+	require.Equal(t, uint64(60), stats.AvgAckTime)
+
+	// stats of child chain
+	childCheckpoint := hmTypes.CreateBlock(0, 127, hmTypes.HexToHeimdallHash("222"), proposer.Signer, "80002", uint64(1000))
+	chainParams := app.ChainKeeper.GetParams(ctx)
+	chainParams.ChildChains = []chainmanagerTypes.ChildChainParams{
+		{
+			BorChainID:           "80002",
+			RootChainAddress:     hmTypes.HexToHeimdallAddress("0x0000000000000000000000000000000000002000"),
+			StateReceiverAddress: chainmanagerTypes.DefaultStateReceiverAddress,
+			ValidatorSetAddress:  chainmanagerTypes.DefaultValidatorSetAddress,
+		},
+	}
+	app.ChainKeeper.SetParams(ctx, chainParams)
+	app.CheckpointKeeper.RecordCheckpointBuffered(ctx, childCheckpoint)
+
+	res, err = querier(ctx, []string{types.QueryStats}, abci.RequestQuery{Data: app.Codec().MustMarshalJSON(types.NewQueryBorChainID("80002"))})
+	require.NoError(t, err)
+	require.NoError(t, json.Unmarshal(res, &stats))
+	require.Equal(t, uint64(1), stats.Buffered)
+	require.Equal(t, uint64(0), stats.Acked)
+
+	req := abci.RequestQuery{
+		Path: fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryProposerStats),
+		Data: app.Codec().MustMarshalJSON(types.NewQueryProposerStatsParams(proposer.ID, "")),
+	}
+	res, err = querier(ctx, []string{types.QueryProposerStats}, req)
+	require.NoError(t, err)
+
+	var proposerStats types.ProposerStatsResponse
+	require.NoError(t, json.Unmarshal(res, &proposerStats))
+	require.Equal(t, proposer.ID, proposerStats.ValidatorID)
+	require.Equal(t, uint64(1), proposerStats.Buffered)
+	require.Equal(t, float64(1), proposerStats.SuccessRate)
+}
@@ -40,6 +40,13 @@ func SideHandleMsgCheckpoint(ctx sdk.Context, k Keeper, msg types.MsgCheckpoint,
 	// logger
 	logger := k.Logger(ctx)
 
+	// read bor data from child chain of checkpoint
+	contractCaller, err := getChildChainCaller(ctx, k, msg.BorChainID, contractCaller)
+	if err != nil {
+		logger.Error("Unable to get child chain caller", "error", err, "borChainID", msg.BorChainID)
+		return common.ErrorSideTx(k.Codespace(), common.CodeInvalidBlockInput)
+	}
+
 	// validate checkpoint
 	validCheckpoint, err := types.ValidateCheckpoint(msg.StartBlock, msg.EndBlock, msg.RootHash, params.MaxCheckpointLength, contractCaller)
 	if err != nil {
@@ -69,13 +76,19 @@ func SideHandleMsgCheckpointAck(ctx sdk.Context, k Keeper, msg types.MsgCheckpoi
 	logger := k.Logger(ctx)
 
 	params := k.GetParams(ctx)
-	chainParams := k.ck.GetParams(ctx).ChainParams
+	chainmanagerParams := k.ck.GetParams(ctx)
+
+	// ack of secondary child chain is validated against its root chain contract
+	rootChainAddress := chainmanagerParams.ChainParams.RootChainAddress
+	if childChain, ok := chainmanagerParams.GetChildChain(msg.BorChainID); ok {
+		rootChainAddress = childChain.RootChainAddress
+	}
 
 	//
 	// Validate data from root chain
 	//
 
-	rootChainInstance, err := contractCaller.GetRootChainInstance(chainParams.RootChainAddress.EthAddress())
+	rootChainInstance, err := contractCaller.GetRootChainInstance(rootChainAddress.EthAddress())
 	if err != nil {
 		logger.Error("Unable to fetch rootchain contract instance", "error", err)
 		return common.ErrorSideTx(k.Codespace(), common.CodeInvalidACK)
@@ -103,6 +116,15 @@ func SideHandleMsgCheckpointAck(ctx sdk.Context, k Keeper, msg types.MsgCheckpoi
 	return
 }
 
+// getChildChainCaller returns contract caller reading bor data of given child chain, primary chain uses default caller
+func getChildChainCaller(ctx sdk.Context, k Keeper, borChainID string, contractCaller helper.IContractCaller) (helper.IContractCaller, error) {
+	if !k.ck.GetParams(ctx).IsSecondaryChain(borChainID) {
+		return contractCaller, nil
+	}
+
+	return contractCaller.GetChildChainCaller(borChainID)
+}
+
 //
 // Tx handler
 //
@@ -138,7 +160,7 @@ func PostHandleMsgCheckpoint(ctx sdk.Context, k Keeper, msg types.MsgCheckpoint,
 	//
 
 	// fetch last checkpoint from store
-	if lastCheckpoint, err := k.GetLastCheckpoint(ctx); err == nil {
+	if lastCheckpoint, err := k.GetLastCheckpointForChain(ctx, msg.BorChainID); err == nil {
 		// make sure new checkpoint is after tip
 		if lastCheckpoint.EndBlock > msg.StartBlock {
 			logger.Error("Checkpoint already exists",
@@ -164,7 +186,7 @@ func PostHandleMsgCheckpoint(ctx sdk.Context, k Keeper, msg types.MsgCheckpoint,
 	// Save checkpoint to buffer store
 	//
 
-	checkpointBuffer, err := k.GetCheckpointFromBuffer(ctx)
+	checkpointBuffer, err := k.GetCheckpointFromBufferForChain(ctx, msg.BorChainID)
 	if err == nil && checkpointBuffer != nil {
 		logger.Debug("Checkpoint already exists in buffer")
 
@@ -179,14 +201,16 @@ func PostHandleMsgCheckpoint(ctx sdk.Context, k Keeper, msg types.MsgCheckpoint,
 	timeStamp := uint64(ctx.BlockTime().Unix())
 
 	// Add checkpoint to buffer with root hash and account hash
-	k.SetCheckpointBuffer(ctx, hmTypes.Checkpoint{
+	checkpoint := hmTypes.Checkpoint{
 		StartBlock: msg.StartBlock,
 		EndBlock:   msg.EndBlock,
 		RootHash:   msg.RootHash,
 		Proposer:   msg.Proposer,
 		BorChainID: msg.BorChainID,
 		TimeStamp:  timeStamp,
-	})
+	}
+	k.SetCheckpointBuffer(ctx, checkpoint)
+	k.RecordCheckpointBuffered(ctx, checkpoint)
 
 	logger.Debug("New checkpoint into buffer stored",
 		"startBlock", msg.StartBlock,
@@ -198,6 +222,16 @@ func PostHandleMsgCheckpoint(ctx sdk.Context, k Keeper, msg types.MsgCheckpoint,
 	txBytes := ctx.TxBytes()
 	hash := tmTypes.Tx(txBytes).Hash()
 
+	// Store side-tx data and sigs which approved checkpoint, root chain submission can be rebuilt from state
+	sideTxData := msg.GetSideSignBytes()
+	if sigs := helper.GetSideTxSigsFromResult(hash, sideTxData, hmTypes.GetSideTxSigs(ctx)); len(sigs) > 0 {
+		k.SetCheckpointBufferSignatures(ctx, msg.BorChainID, types.CheckpointSignatures{
+			TxHash: hmTypes.BytesToHeimdallHash(hash),
+			Data:   sideTxData,
+			Sigs:   sigs,
+		})
+	}
+
 	// Emit event for checkpoints
 	ctx.EventManager().EmitEvents(sdk.Events{
 		sdk.NewEvent(
@@ -229,8 +263,8 @@ func PostHandleMsgCheckpointAck(ctx sdk.Context, k Keeper, msg types.MsgCheckpoi
 		return common.ErrBadBlockDetails(k.Codespace()).Result()
 	}
 
-	// get last checkpoint from buffer
-	checkpointObj, err := k.GetCheckpointFromBuffer(ctx)
+	// get last checkpoint from buffer of child chain
+	checkpointObj, err := k.GetCheckpointFromBufferForChain(ctx, msg.BorChainID)
 	if err != nil {
 		logger.Error("Unable to get checkpoint buffer", "error", err)
 		return common.ErrBadAck(k.Codespace()).Result()
@@ -274,13 +308,19 @@ func PostHandleMsgCheckpointAck(ctx sdk.Context, k Keeper, msg types.MsgCheckpoi
 	}
 	logger.Debug("Checkpoint added to store", "checkpointNumber", msg.Number)
 
+	k.RecordCheckpointAcked(ctx, msg.Number, *checkpointObj)
+
+	// Keep side-tx sigs of acked checkpoint
+	k.AckCheckpointBufferSignatures(ctx, msg.BorChainID, msg.Number)
+
 	// Flush buffer
-	k.FlushCheckpointBuffer(ctx)
+	k.FlushCheckpointBufferForChain(ctx, msg.BorChainID)
 	logger.Debug("Checkpoint buffer flushed after receiving checkpoint ack")
 
-	// Update ack count in staking module
-	k.UpdateACKCount(ctx)
-	logger.Info("Valid ack received", "CurrentACKCount", k.GetACKCount(ctx)-1, "UpdatedACKCount", k.GetACKCount(ctx))
+	// Update ack count of child chain
+	k.UpdateACKCountForChain(ctx, msg.BorChainID)
+	ackCount := k.GetACKCountForChain(ctx, msg.BorChainID)
+	logger.Info("Valid ack received", "borChainID", msg.BorChainID, "CurrentACKCount", ackCount-1, "UpdatedACKCount", ackCount)
 
 	// Increment accum (selects new proposer)
 	k.sk.IncrementAccum(ctx, 1)
@@ -1,8 +1,10 @@
 package checkpoint_test
 
 import (
+	"bytes"
 	"math/big"
 	"math/rand"
+	"sort"
 	"testing"
 	"time"
 
@@ -17,6 +19,8 @@ import (
 	"github.com/maticnetwork/heimdall/helper/mocks"
 	hmTypes "github.com/maticnetwork/heimdall/types"
 	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+	tmTypes "github.com/tendermint/tendermint/types"
 
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
@@ -168,6 +172,7 @@ func (suite *SideHandlerTestSuite) TestSideHandleMsgCheckpointAck() {
 			header.RootHash,
 			hmTypes.HexToHeimdallHash("123123"),
 			uint64(1),
+			header.BorChainID,
 		)
 		rootchainInstance := &rootchain.Rootchain{}
 
@@ -192,6 +197,7 @@ func (suite *SideHandlerTestSuite) TestSideHandleMsgCheckpointAck() {
 			hmTypes.HexToHeimdallHash("123"),
 			hmTypes.HexToHeimdallHash("123123"),
 			uint64(1),
+			header.BorChainID,
 		)
 		rootchainInstance := &rootchain.Rootchain{}
 
@@ -302,6 +308,87 @@ func (suite *SideHandlerTestSuite) TestPostHandleMsgCheckpoint() {
 	})
 }
 
+func (suite *SideHandlerTestSuite) TestPostHandleMsgCheckpointSignatures() {
+	t, app, ctx := suite.T(), suite.app, suite.ctx
+	keeper := app.CheckpointKeeper
+	stakingKeeper := app.StakingKeeper
+	params := keeper.GetParams(ctx)
+
+	chSim.LoadValidatorSet(2, t, stakingKeeper, ctx, false, 10)
+	stakingKeeper.IncrementAccum(ctx, 1)
+
+	header, err := chSim.GenRandCheckpoint(0, 256, params.MaxCheckpointLength)
+	require.NoError(t, err)
+	header.Proposer = stakingKeeper.GetValidatorSet(ctx).Proposer.Signer
+
+	msgCheckpoint := types.NewMsgCheckpointBlock(
+		header.Proposer,
+		header.StartBlock,
+		header.EndBlock,
+		header.RootHash,
+		header.RootHash,
+		"1234",
+	)
+
+	txBytes := []byte("checkpoint-tx")
+	txHash := tmTypes.Tx(txBytes).Hash()
+	signedData := (&tmTypes.SideTxResultWithData{
+		SideTxResult: tmTypes.SideTxResult{
+			TxHash: txHash,
+			Result: int32(abci.SideTxResultType_Yes),
+		},
+		Data: msgCheckpoint.GetSideSignBytes(),
+	}).GetBytes()
+
+	// valid sigs, sorted by signer address
+	privKeys := []secp256k1.PrivKeySecp256k1{secp256k1.GenPrivKey(), secp256k1.GenPrivKey()}
+	sort.Slice(privKeys, func(i, j int) bool {
+		return bytes.Compare(privKeys[i].PubKey().Address(), privKeys[j].PubKey().Address()) < 0
+	})
+
+	var expectedSigs []byte
+	var sideTxSigs []abci.SideTxSig
+	for i := len(privKeys) - 1; i >= 0; i-- {
+		sig, err := privKeys[i].Sign(signedData)
+		require.NoError(t, err)
+		expectedSigs = append(sig, expectedSigs...)
+		sideTxSigs = append(sideTxSigs, abci.SideTxSig{
+			Result:  abci.SideTxResultType_Yes,
+			Sig:     sig,
+			Address: privKeys[i].PubKey().Address(),
+		})
+	}
+
+	// sig from other address is skipped
+	otherSig, err := secp256k1.GenPrivKey().Sign(signedData)
+	require.NoError(t, err)
+	sideTxSigs = append(sideTxSigs, abci.SideTxSig{
+		Result:  abci.SideTxResultType_Yes,
+		Sig:     otherSig,
+		Address: privKeys[0].PubKey().Address(),
+	})
+
+	sigCtx := hmTypes.WithSideTxSigs(ctx.WithTxBytes(txBytes), sideTxSigs)
+	result := suite.postHandler(sigCtx, msgCheckpoint, abci.SideTxResultType_Yes)
+	require.True(t, result.IsOK(), "expected send-checkpoint to be ok, got %v", result)
+
+	signatures, err := keeper.GetCheckpointBufferSignaturesForChain(ctx, "1234")
+	require.NoError(t, err)
+	require.Equal(t, hmTypes.BytesToHeimdallHash(txHash), signatures.TxHash)
+	require.Equal(t, msgCheckpoint.GetSideSignBytes(), signatures.Data.Bytes())
+	require.Equal(t, expectedSigs, signatures.Sigs.Bytes())
+
+	// sigs are kept under checkpoint number on ack
+	keeper.AckCheckpointBufferSignatures(ctx, "1234", 1)
+
+	_, err = keeper.GetCheckpointBufferSignaturesForChain(ctx, "1234")
+	require.Error(t, err)
+
+	ackedSignatures, err := keeper.GetCheckpointSignaturesForChain(ctx, "1234", 1)
+	require.NoError(t, err)
+	require.Equal(t, signatures, ackedSignatures)
+}
+
 func (suite *SideHandlerTestSuite) TestPostHandleMsgCheckpointAck() {
 	t, app, ctx := suite.T(), suite.app, suite.ctx
 	keeper := app.CheckpointKeeper
@@ -327,6 +414,7 @@ func (suite *SideHandlerTestSuite) TestPostHandleMsgCheckpointAck() {
 			header.RootHash,
 			hmTypes.HexToHeimdallHash("123123"),
 			uint64(1),
+			header.BorChainID,
 		)
 
 		result := suite.postHandler(ctx, msgCheckpointAck, abci.SideTxResultType_No)
@@ -358,6 +446,7 @@ func (suite *SideHandlerTestSuite) TestPostHandleMsgCheckpointAck() {
 			header.RootHash,
 			hmTypes.HexToHeimdallHash("123123"),
 			uint64(1),
+			header.BorChainID,
 		)
 
 		result = suite.postHandler(ctx, msgCheckpointAck, abci.SideTxResultType_Yes)
@@ -377,6 +466,7 @@ func (suite *SideHandlerTestSuite) TestPostHandleMsgCheckpointAck() {
 			header.RootHash,
 			hmTypes.HexToHeimdallHash("123123"),
 			uint64(1),
+			header.BorChainID,
 		)
 
 		result := suite.postHandler(ctx, msgCheckpointAck, abci.SideTxResultType_Yes)
@@ -411,6 +501,7 @@ func (suite *SideHandlerTestSuite) TestPostHandleMsgCheckpointAck() {
 			header2.RootHash,
 			hmTypes.HexToHeimdallHash("123123"),
 			uint64(1),
+			header2.BorChainID,
 		)
 
 		result = suite.postHandler(ctx, msgCheckpointAck, abci.SideTxResultType_Yes)
@@ -3,6 +3,7 @@ package types
 import (
 	"encoding/json"
 	"errors"
+	"fmt"
 
 	"github.com/maticnetwork/heimdall/bor/types"
 	hmTypes "github.com/maticnetwork/heimdall/types"
@@ -16,6 +17,22 @@ type GenesisState struct {
 	LastNoACK          uint64               `json:"last_no_ack" yaml:"last_no_ack"`
 	AckCount           uint64               `json:"ack_count" yaml:"ack_count"`
 	Checkpoints        []hmTypes.Checkpoint `json:"checkpoints" yaml:"checkpoints"`
+
+	BufferedCheckpointSignatures *CheckpointSignatures       `json:"buffered_checkpoint_signatures" yaml:"buffered_checkpoint_signatures"`
+	CheckpointSignatures         []AckedCheckpointSignatures `json:"checkpoint_signatures" yaml:"checkpoint_signatures"`
+
+	ChildChains []ChildChainGenesisState `json:"child_chains" yaml:"child_chains"` // state of secondary child chains
+}
+
+// ChildChainGenesisState is the checkpoint state of secondary child chain
+type ChildChainGenesisState struct {
+	BorChainID         string               `json:"bor_chain_id" yaml:"bor_chain_id"`
+	BufferedCheckpoint *hmTypes.Checkpoint  `json:"buffered_checkpoint" yaml:"buffered_checkpoint"`
+	AckCount           uint64               `json:"ack_count" yaml:"ack_count"`
+	Checkpoints        []hmTypes.Checkpoint `json:"checkpoints" yaml:"checkpoints"`
+
+	BufferedCheckpointSignatures *CheckpointSignatures       `json:"buffered_checkpoint_signatures" yaml:"buffered_checkpoint_signatures"`
+	CheckpointSignatures         []AckedCheckpointSignatures `json:"checkpoint_signatures" yaml:"checkpoint_signatures"`
 }
 
 // NewGenesisState creates a new genesis state.
@@ -55,6 +72,41 @@ func ValidateGenesis(data GenesisState) error {
 		}
 	}
 
+	if err := validateCheckpointSignatures(data.CheckpointSignatures, data.AckCount); err != nil {
+		return err
+	}
+
+	for _, childChain := range data.ChildChains {
+		if int(childChain.AckCount) != len(childChain.Checkpoints) {
+			return fmt.Errorf("Incorrect state of child chain %s in state-dump , Please Check", childChain.BorChainID)
+		}
+
+		for _, checkpoint := range childChain.Checkpoints {
+			if checkpoint.BorChainID != childChain.BorChainID {
+				return fmt.Errorf("Checkpoint of chain %s in state of child chain %s", checkpoint.BorChainID, childChain.BorChainID)
+			}
+		}
+
+		if childChain.BufferedCheckpoint != nil && childChain.BufferedCheckpoint.BorChainID != childChain.BorChainID {
+			return fmt.Errorf("Buffered checkpoint of chain %s in state of child chain %s", childChain.BufferedCheckpoint.BorChainID, childChain.BorChainID)
+		}
+
+		if err := validateCheckpointSignatures(childChain.CheckpointSignatures, childChain.AckCount); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// validateCheckpointSignatures checks that sigs belong to acked checkpoints
+func validateCheckpointSignatures(signatures []AckedCheckpointSignatures, ackCount uint64) error {
+	for _, signature := range signatures {
+		if signature.Number == 0 || signature.Number > ackCount {
+			return fmt.Errorf("Signatures of unknown checkpoint %d in state-dump", signature.Number)
+		}
+	}
+
 	return nil
 }
 
@@ -3,9 +3,12 @@ package types
 import (
 	"bytes"
 	"errors"
+	"math/big"
 
 	"github.com/cbergoon/merkletree"
 	"github.com/maticnetwork/bor/common"
+	ethTypes "github.com/maticnetwork/bor/core/types"
+	"github.com/maticnetwork/bor/crypto"
 	"github.com/maticnetwork/bor/rpc"
 	"github.com/tendermint/crypto/sha3"
 	"golang.org/x/sync/errgroup"
@@ -104,6 +107,80 @@ func VerifyAccountProof(dividendAccounts []hmTypes.DividendAccount, userAddr hmT
 	return false, nil
 }
 
+// GetBlockHeaderHash returns leaf of block header in checkpoint root hash tree
+func GetBlockHeaderHash(header *ethTypes.Header) []byte {
+	return crypto.Keccak256(appendBytes32(
+		header.Number.Bytes(),
+		new(big.Int).SetUint64(header.Time).Bytes(),
+		header.TxHash.Bytes(),
+		header.ReceiptHash.Bytes(),
+	))
+}
+
+// GetBlockProof returns proof of block header in root hash tree of given headers (same tree bor uses for root hash),
+// along with index of block and root hash
+func GetBlockProof(headers []*ethTypes.Header, blockNumber uint64) ([]byte, uint64, []byte, error) {
+	if len(headers) == 0 {
+		return nil, 0, nil, errors.New("no headers")
+	}
+
+	start := headers[0].Number.Uint64()
+	if blockNumber < start || blockNumber-start >= uint64(len(headers)) {
+		return nil, 0, nil, errors.New("block not in headers")
+	}
+	index := blockNumber - start
+
+	// leaves padded with empty hashes to power of two
+	leaves := make([][]byte, nextPowerOfTwo(uint64(len(headers))))
+	for i := range leaves {
+		if i < len(headers) {
+			leaves[i] = GetBlockHeaderHash(headers[i])
+		} else {
+			leaves[i] = make([]byte, common.HashLength)
+		}
+	}
+
+	// collect siblings while hashing up the tree
+	var branch [][]byte
+	for position := index; len(leaves) > 1; position /= 2 {
+		branch = append(branch, leaves[position^1])
+
+		next := make([][]byte, len(leaves)/2)
+		for i := range next {
+			next[i] = crypto.Keccak256(leaves[2*i], leaves[2*i+1])
+		}
+		leaves = next
+	}
+
+	return appendBytes32(branch...), index, leaves[0], nil
+}
+
+// VerifyBlockProof verifies proof of block header leaf at index against root hash
+func VerifyBlockProof(leaf []byte, index uint64, proof []byte, rootHash []byte) bool {
+	if len(proof)%common.HashLength != 0 {
+		return false
+	}
+
+	// index must fit in the tree
+	depth := uint(len(proof) / common.HashLength)
+	if depth < 64 && index >= uint64(1)<<depth {
+		return false
+	}
+
+	computed := leaf
+	for i := 0; i < len(proof); i += common.HashLength {
+		sibling := proof[i : i+common.HashLength]
+		if index%2 == 0 {
+			computed = crypto.Keccak256(computed, sibling)
+		} else {
+			computed = crypto.Keccak256(sibling, computed)
+		}
+		index /= 2
+	}
+
+	return bytes.Equal(computed, rootHash)
+}
+
 func convert(input []([32]byte)) [][]byte {
 	var output [][]byte
 	for _, in := range input {
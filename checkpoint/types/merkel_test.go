@@ -0,0 +1,75 @@
+package types
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/maticnetwork/bor/common"
+	ethTypes "github.com/maticnetwork/bor/core/types"
+	"github.com/maticnetwork/bor/crypto"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBlockProof(t *testing.T) {
+	headers := make([]*ethTypes.Header, 0, 5)
+	for number := uint64(1); number <= 5; number++ {
+		headers = append(headers, &ethTypes.Header{
+			Number:      new(big.Int).SetUint64(number),
+			Time:        1000 + number,
+			TxHash:      common.BigToHash(new(big.Int).SetUint64(number)),
+			ReceiptHash: common.BigToHash(new(big.Int).SetUint64(100 + number)),
+		})
+	}
+	rootHash := headerRootHash(headers)
+
+	// proof tree is the one bor uses for root hash
+	for number := uint64(1); number <= 5; number++ {
+		proof, index, root, err := GetBlockProof(headers, number)
+		require.NoError(t, err)
+		require.Equal(t, number-1, index)
+		require.Equal(t, rootHash, root)
+
+		leaf := GetBlockHeaderHash(headers[index])
+		require.True(t, VerifyBlockProof(leaf, index, proof, rootHash))
+		require.False(t, VerifyBlockProof(leaf, index^1, proof, rootHash))
+		require.False(t, VerifyBlockProof(leaf, index+8, proof, rootHash))
+	}
+
+	// block is not part of headers
+	_, _, _, err := GetBlockProof(headers, 6)
+	require.Error(t, err)
+
+	_, _, _, err = GetBlockProof(nil, 1)
+	require.Error(t, err)
+}
+
+// headerRootHash returns root of merkle tree of header hashes, padded with empty leaves to power of two (as bor computes it)
+func headerRootHash(headers []*ethTypes.Header) []byte {
+	length := uint64(1)
+	for length < uint64(len(headers)) {
+		length *= 2
+	}
+
+	leaves := make([][]byte, length)
+	for i := range leaves {
+		leaves[i] = make([]byte, common.HashLength)
+		if i < len(headers) {
+			leaves[i] = crypto.Keccak256(
+				common.LeftPadBytes(headers[i].Number.Bytes(), 32),
+				common.LeftPadBytes(new(big.Int).SetUint64(headers[i].Time).Bytes(), 32),
+				headers[i].TxHash.Bytes(),
+				headers[i].ReceiptHash.Bytes(),
+			)
+		}
+	}
+
+	for len(leaves) > 1 {
+		next := make([][]byte, len(leaves)/2)
+		for i := range next {
+			next[i] = crypto.Keccak256(leaves[2*i], leaves[2*i+1])
+		}
+		leaves = next
+	}
+
+	return leaves[0]
+}
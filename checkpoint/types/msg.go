@@ -115,6 +115,7 @@ type MsgCheckpointAck struct {
 	RootHash   types.HeimdallHash    `json:"root_hash"`
 	TxHash     types.HeimdallHash    `json:"tx_hash"`
 	LogIndex   uint64                `json:"log_index"`
+	BorChainID string                `json:"bor_chain_id"`
 }
 
 func NewMsgCheckpointAck(
@@ -126,6 +127,7 @@ func NewMsgCheckpointAck(
 	rootHash types.HeimdallHash,
 	txHash types.HeimdallHash,
 	logIndex uint64,
+	borChainID string,
 ) MsgCheckpointAck {
 
 	return MsgCheckpointAck{
@@ -137,6 +139,7 @@ func NewMsgCheckpointAck(
 		RootHash:   rootHash,
 		TxHash:     txHash,
 		LogIndex:   logIndex,
+		BorChainID: borChainID,
 	}
 }
 
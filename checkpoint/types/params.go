@@ -6,6 +6,8 @@ import (
 	"strings"
 	"time"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
 	"github.com/maticnetwork/heimdall/params/subspace"
 )
 
@@ -15,14 +17,32 @@ const (
 	DefaultAvgCheckpointLength  uint64        = 256
 	DefaultMaxCheckpointLength  uint64        = 1024
 	DefaultChildBlockInterval   uint64        = 10000
+
+	DefaultAdaptiveCheckpointLength bool          = false
+	DefaultTargetCheckpointPeriod   time.Duration = 30 * time.Minute // Time targeted between checkpoints in adaptive mode
+	DefaultCheckpointLengthWindow   uint64        = 10               // Number of recent checkpoints used to derive adaptive length
+
+	DefaultNoAckMissWindow    uint64 = 0 // Number of recent buffered checkpoints of proposer tracked for misses (disabled until set by governance)
+	DefaultNoAckMissThreshold uint64 = 0 // Proposer is slashed when misses in window go past threshold
 )
 
+// DefaultSlashFractionNoAck is fraction of power slashed when proposer goes past no-ack miss threshold (disabled until set by governance)
+var DefaultSlashFractionNoAck = sdk.ZeroDec()
+
 // Parameter keys
 var (
 	KeyCheckpointBufferTime = []byte("CheckpointBufferTime")
 	KeyAvgCheckpointLength  = []byte("AvgCheckpointLength")
 	KeyMaxCheckpointLength  = []byte("MaxCheckpointLength")
 	KeyChildBlockInterval   = []byte("ChildBlockInterval")
+
+	KeyAdaptiveCheckpointLength = []byte("AdaptiveCheckpointLength")
+	KeyTargetCheckpointPeriod   = []byte("TargetCheckpointPeriod")
+	KeyCheckpointLengthWindow   = []byte("CheckpointLengthWindow")
+
+	KeyNoAckMissWindow    = []byte("NoAckMissWindow")
+	KeyNoAckMissThreshold = []byte("NoAckMissThreshold")
+	KeySlashFractionNoAck = []byte("SlashFractionNoAck")
 )
 
 var _ subspace.ParamSet = &Params{}
@@ -33,6 +53,16 @@ type Params struct {
 	AvgCheckpointLength  uint64        `json:"avg_checkpoint_length" yaml:"avg_checkpoint_length"`
 	MaxCheckpointLength  uint64        `json:"max_checkpoint_length" yaml:"max_checkpoint_length"`
 	ChildBlockInterval   uint64        `json:"child_chain_block_interval" yaml:"child_chain_block_interval"`
+
+	// adaptive mode derives checkpoint length from recent checkpoints to reach target period, capped by max length
+	AdaptiveCheckpointLength bool          `json:"adaptive_checkpoint_length" yaml:"adaptive_checkpoint_length"`
+	TargetCheckpointPeriod   time.Duration `json:"target_checkpoint_period" yaml:"target_checkpoint_period"`
+	CheckpointLengthWindow   uint64        `json:"checkpoint_length_window" yaml:"checkpoint_length_window"`
+
+	// proposer whose buffered checkpoints are flushed without ack too often is slashed, zero window or fraction disables it
+	NoAckMissWindow    uint64  `json:"no_ack_miss_window" yaml:"no_ack_miss_window"`
+	NoAckMissThreshold uint64  `json:"no_ack_miss_threshold" yaml:"no_ack_miss_threshold"`
+	SlashFractionNoAck sdk.Dec `json:"slash_fraction_no_ack" yaml:"slash_fraction_no_ack"`
 }
 
 // NewParams creates a new Params object
@@ -64,6 +94,12 @@ func (p *Params) ParamSetPairs() subspace.ParamSetPairs {
 		{KeyAvgCheckpointLength, &p.AvgCheckpointLength},
 		{KeyMaxCheckpointLength, &p.MaxCheckpointLength},
 		{KeyChildBlockInterval, &p.ChildBlockInterval},
+		{KeyAdaptiveCheckpointLength, &p.AdaptiveCheckpointLength},
+		{KeyTargetCheckpointPeriod, &p.TargetCheckpointPeriod},
+		{KeyCheckpointLengthWindow, &p.CheckpointLengthWindow},
+		{KeyNoAckMissWindow, &p.NoAckMissWindow},
+		{KeyNoAckMissThreshold, &p.NoAckMissThreshold},
+		{KeySlashFractionNoAck, &p.SlashFractionNoAck},
 	}
 }
 
@@ -81,6 +117,14 @@ func DefaultParams() Params {
 		AvgCheckpointLength:  DefaultAvgCheckpointLength,
 		MaxCheckpointLength:  DefaultMaxCheckpointLength,
 		ChildBlockInterval:   DefaultChildBlockInterval,
+
+		AdaptiveCheckpointLength: DefaultAdaptiveCheckpointLength,
+		TargetCheckpointPeriod:   DefaultTargetCheckpointPeriod,
+		CheckpointLengthWindow:   DefaultCheckpointLengthWindow,
+
+		NoAckMissWindow:    DefaultNoAckMissWindow,
+		NoAckMissThreshold: DefaultNoAckMissThreshold,
+		SlashFractionNoAck: DefaultSlashFractionNoAck,
 	}
 }
 
@@ -92,6 +136,12 @@ func (p Params) String() string {
 	sb.WriteString(fmt.Sprintf("AvgCheckpointLength: %d\n", p.AvgCheckpointLength))
 	sb.WriteString(fmt.Sprintf("MaxCheckpointLength: %d\n", p.MaxCheckpointLength))
 	sb.WriteString(fmt.Sprintf("ChildBlockInterval: %d\n", p.ChildBlockInterval))
+	sb.WriteString(fmt.Sprintf("AdaptiveCheckpointLength: %t\n", p.AdaptiveCheckpointLength))
+	sb.WriteString(fmt.Sprintf("TargetCheckpointPeriod: %s\n", p.TargetCheckpointPeriod))
+	sb.WriteString(fmt.Sprintf("CheckpointLengthWindow: %d\n", p.CheckpointLengthWindow))
+	sb.WriteString(fmt.Sprintf("NoAckMissWindow: %d\n", p.NoAckMissWindow))
+	sb.WriteString(fmt.Sprintf("NoAckMissThreshold: %d\n", p.NoAckMissThreshold))
+	sb.WriteString(fmt.Sprintf("SlashFractionNoAck: %s\n", p.SlashFractionNoAck))
 	return sb.String()
 }
 
@@ -109,5 +159,25 @@ func (p Params) Validate() error {
 		return fmt.Errorf("ChildBlockInterval should be greater than zero")
 	}
 
+	if p.AdaptiveCheckpointLength {
+		if p.TargetCheckpointPeriod <= 0 {
+			return fmt.Errorf("TargetCheckpointPeriod should be greater than zero in adaptive mode")
+		}
+
+		if p.CheckpointLengthWindow < 2 {
+			return fmt.Errorf("CheckpointLengthWindow should be at least 2 in adaptive mode")
+		}
+	}
+
+	if p.NoAckMissWindow > 0 {
+		if p.NoAckMissThreshold >= p.NoAckMissWindow {
+			return fmt.Errorf("NoAckMissThreshold should be less than NoAckMissWindow")
+		}
+
+		if p.SlashFractionNoAck.IsNil() || p.SlashFractionNoAck.IsNegative() || p.SlashFractionNoAck.GT(sdk.OneDec()) {
+			return fmt.Errorf("SlashFractionNoAck should be between 0 and 1")
+		}
+	}
+
 	return nil
 }
@@ -1,27 +1,52 @@
 package types
 
+import (
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
 // query endpoints supported by the auth Querier
 const (
-	QueryParams           = "params"
-	QueryAckCount         = "ack-count"
-	QueryCheckpoint       = "checkpoint"
-	QueryCheckpointBuffer = "checkpoint-buffer"
-	QueryLastNoAck        = "last-no-ack"
-	QueryCheckpointList   = "checkpoint-list"
-	QueryNextCheckpoint   = "next-checkpoint"
-	QueryProposer         = "is-proposer"
-	QueryCurrentProposer  = "current-proposer"
-	StakingQuerierRoute   = "staking"
+	QueryParams            = "params"
+	QueryAckCount          = "ack-count"
+	QueryCheckpoint        = "checkpoint"
+	QueryCheckpointBuffer  = "checkpoint-buffer"
+	QueryLastNoAck         = "last-no-ack"
+	QueryCheckpointList    = "checkpoint-list"
+	QueryNextCheckpoint    = "next-checkpoint"
+	QueryProposer          = "is-proposer"
+	QueryCurrentProposer   = "current-proposer"
+	QueryCheckpointByBlock = "checkpoint-by-block"
+	QueryCheckpointByRoot  = "checkpoint-by-root"
+	QueryCheckpointProof   = "checkpoint-proof"
+	QueryStats             = "stats"
+	QueryProposerStats     = "proposer-stats"
+	QueryCheckpointLength  = "checkpoint-length"
+	QuerySignatures        = "checkpoint-signatures"
+	QueryBufferSignatures  = "checkpoint-buffer-signatures"
+	StakingQuerierRoute    = "staking"
 )
 
 // QueryCheckpointParams defines the params for querying accounts.
 type QueryCheckpointParams struct {
-	Number uint64
+	Number     uint64
+	BorChainID string
 }
 
 // NewQueryCheckpointParams creates a new instance of QueryCheckpointHeaderIndex.
-func NewQueryCheckpointParams(number uint64) QueryCheckpointParams {
-	return QueryCheckpointParams{Number: number}
+func NewQueryCheckpointParams(number uint64, borChainID string) QueryCheckpointParams {
+	return QueryCheckpointParams{Number: number, BorChainID: borChainID}
+}
+
+// QueryCheckpointListParams defines the params for querying checkpoints of child chain with pagination
+type QueryCheckpointListParams struct {
+	Page       uint64
+	Limit      uint64
+	BorChainID string
+}
+
+// NewQueryCheckpointListParams creates a new instance of QueryCheckpointListParams
+func NewQueryCheckpointListParams(page uint64, limit uint64, borChainID string) QueryCheckpointListParams {
+	return QueryCheckpointListParams{Page: page, Limit: limit, BorChainID: borChainID}
 }
 
 // QueryBorChainID defines the params for querying with bor chain id
@@ -33,3 +58,58 @@ type QueryBorChainID struct {
 func NewQueryBorChainID(chainID string) QueryBorChainID {
 	return QueryBorChainID{BorChainID: chainID}
 }
+
+// QueryBorBlockParams defines the params for querying checkpoint covering a bor block
+type QueryBorBlockParams struct {
+	BorBlock   uint64
+	BorChainID string
+}
+
+// NewQueryBorBlockParams creates a new instance of QueryBorBlockParams
+func NewQueryBorBlockParams(borBlock uint64, borChainID string) QueryBorBlockParams {
+	return QueryBorBlockParams{BorBlock: borBlock, BorChainID: borChainID}
+}
+
+// QueryRootHashParams defines the params for querying checkpoint by root hash
+type QueryRootHashParams struct {
+	RootHash   hmTypes.HeimdallHash
+	BorChainID string
+}
+
+// NewQueryRootHashParams creates a new instance of QueryRootHashParams
+func NewQueryRootHashParams(rootHash hmTypes.HeimdallHash, borChainID string) QueryRootHashParams {
+	return QueryRootHashParams{RootHash: rootHash, BorChainID: borChainID}
+}
+
+// QueryProposerStatsParams defines the params for querying checkpoint stats of validator on child chain
+type QueryProposerStatsParams struct {
+	ValidatorID hmTypes.ValidatorID
+	BorChainID  string
+}
+
+// NewQueryProposerStatsParams creates a new instance of QueryProposerStatsParams
+func NewQueryProposerStatsParams(valID hmTypes.ValidatorID, borChainID string) QueryProposerStatsParams {
+	return QueryProposerStatsParams{ValidatorID: valID, BorChainID: borChainID}
+}
+
+// CheckpointWithNumber is a checkpoint along with its number
+type CheckpointWithNumber struct {
+	Number     uint64             `json:"number"`
+	Checkpoint hmTypes.Checkpoint `json:"checkpoint"`
+}
+
+// BlockProof is a proof of bor block header inclusion in checkpoint root hash
+type BlockProof struct {
+	BlockNumber      uint64               `json:"block_number"`
+	HeaderHash       hmTypes.HeimdallHash `json:"header_hash"`
+	Index            uint64               `json:"index"`
+	Proof            hmTypes.HexBytes     `json:"proof"`
+	CheckpointNumber uint64               `json:"checkpoint_number"`
+	Checkpoint       hmTypes.Checkpoint   `json:"checkpoint"`
+}
+
+// CheckpointLength is the length of next checkpoint
+type CheckpointLength struct {
+	Length   uint64 `json:"length"`
+	Adaptive bool   `json:"adaptive"`
+}
@@ -0,0 +1,19 @@
+package types
+
+import (
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+// CheckpointSignatures holds side-tx data of checkpoint and validator sigs which approved it,
+// ordered by validator address as expected by `submitHeaderBlock(data, sigs)` on root chain
+type CheckpointSignatures struct {
+	TxHash hmTypes.HeimdallHash `json:"tx_hash"`
+	Data   hmTypes.HexBytes     `json:"data"`
+	Sigs   hmTypes.HexBytes     `json:"sigs"`
+}
+
+// AckedCheckpointSignatures holds side-tx data and sigs of acked checkpoint with its number
+type AckedCheckpointSignatures struct {
+	Number     uint64               `json:"number"`
+	Signatures CheckpointSignatures `json:"signatures"`
+}
@@ -0,0 +1,93 @@
+package types
+
+import (
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+// CheckpointLifecycle records when acked checkpoint entered the buffer and when it was acked
+type CheckpointLifecycle struct {
+	Number     uint64                  `json:"number"`
+	Proposer   hmTypes.HeimdallAddress `json:"proposer"`
+	BufferedAt uint64                  `json:"buffered_at"`
+	AckedAt    uint64                  `json:"acked_at"`
+}
+
+// FlushedCheckpoint records checkpoint flushed from buffer on timeout
+type FlushedCheckpoint struct {
+	Proposer   hmTypes.HeimdallAddress `json:"proposer"`
+	StartBlock uint64                  `json:"start_block"`
+	EndBlock   uint64                  `json:"end_block"`
+	BufferedAt uint64                  `json:"buffered_at"`
+	FlushedAt  uint64                  `json:"flushed_at"`
+}
+
+// NoAckRecord records accepted checkpoint no-ack and the proposer it replaced
+type NoAckRecord struct {
+	From     hmTypes.HeimdallAddress `json:"from"`
+	Proposer hmTypes.HeimdallAddress `json:"proposer"`
+	Time     uint64                  `json:"time"`
+}
+
+// ProposerMissInfo tracks rolling window of proposer's buffered checkpoints, missed ones were flushed without ack
+type ProposerMissInfo struct {
+	ValidatorID   hmTypes.ValidatorID `json:"validator_id"`
+	Window        uint64              `json:"window"` // window size misses were recorded with
+	IndexOffset   uint64              `json:"index_offset"`
+	MissedCounter uint64              `json:"missed_counter"`
+}
+
+// CheckpointStats aggregates checkpoint lifecycle of all proposers
+type CheckpointStats struct {
+	Buffered     uint64 `json:"buffered"`
+	Acked        uint64 `json:"acked"`
+	Flushed      uint64 `json:"flushed"`
+	NoAcks       uint64 `json:"no_acks"`
+	TotalAckTime uint64 `json:"total_ack_time"` // seconds from buffer to ack, summed over acked checkpoints
+}
+
+// AvgAckTime returns average seconds from buffer to ack
+func (s CheckpointStats) AvgAckTime() uint64 {
+	if s.Acked == 0 {
+		return 0
+	}
+	return s.TotalAckTime / s.Acked
+}
+
+// ProposerStats aggregates checkpoint lifecycle of a proposer
+type ProposerStats struct {
+	ValidatorID  hmTypes.ValidatorID `json:"validator_id"`
+	Buffered     uint64              `json:"buffered"`
+	Acked        uint64              `json:"acked"`
+	Flushed      uint64              `json:"flushed"`
+	NoAcks       uint64              `json:"no_acks"` // no-acks which replaced proposer
+	TotalAckTime uint64              `json:"total_ack_time"`
+}
+
+// AvgAckTime returns average seconds from buffer to ack
+func (s ProposerStats) AvgAckTime() uint64 {
+	if s.Acked == 0 {
+		return 0
+	}
+	return s.TotalAckTime / s.Acked
+}
+
+// SuccessRate returns fraction of buffered checkpoints which were acked
+func (s ProposerStats) SuccessRate() float64 {
+	if s.Buffered == 0 {
+		return 0
+	}
+	return float64(s.Acked) / float64(s.Buffered)
+}
+
+// CheckpointStatsResponse is the response of checkpoint stats query
+type CheckpointStatsResponse struct {
+	CheckpointStats
+	AvgAckTime uint64 `json:"avg_ack_time"`
+}
+
+// ProposerStatsResponse is the response of proposer stats query
+type ProposerStatsResponse struct {
+	ProposerStats
+	AvgAckTime  uint64  `json:"avg_ack_time"`
+	SuccessRate float64 `json:"success_rate"`
+}
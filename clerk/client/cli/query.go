@@ -58,7 +58,7 @@ func GetStateRecord(cdc *codec.Codec) *cobra.Command {
 			}
 
 			// get query params
-			queryParams, err := cliCtx.Codec.MarshalJSON(clerkTypes.NewQueryRecordParams(recordID))
+			queryParams, err := cliCtx.Codec.MarshalJSON(clerkTypes.NewQueryRecordParams(recordID, viper.GetString(FlagBorChainId)))
 			if err != nil {
 				return err
 			}
@@ -83,6 +83,7 @@ func GetStateRecord(cdc *codec.Codec) *cobra.Command {
 	}
 
 	cmd.Flags().Uint64(FlagRecordID, 0, "--id=<record ID here>")
+	cmd.Flags().String(FlagBorChainId, "", "--bor-chain-id=<bor-chain-id> (primary chain if empty)")
 
 	if err := cmd.MarkFlagRequired(FlagRecordID); err != nil {
 		logger.Error("GetStateRecord | MarkFlagRequired | FlagRecordID", "Error", err)
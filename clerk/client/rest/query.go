@@ -11,7 +11,6 @@ import (
 	"github.com/gorilla/mux"
 
 	"github.com/maticnetwork/heimdall/clerk/types"
-	hmTypes "github.com/maticnetwork/heimdall/types"
 	hmRest "github.com/maticnetwork/heimdall/types/rest"
 )
 
@@ -46,8 +45,8 @@ func recordHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 			return
 		}
 
-		// get record from store
-		res, err := recordQuery(cliCtx, recordID)
+		// get record of child chain from store
+		res, err := recordQuery(cliCtx, recordID, r.URL.Query().Get("bor_chain_id"))
 		if err != nil {
 			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
 			return
@@ -99,6 +98,9 @@ func recordListHandlerFn(
 		var res []byte
 		var err error
 
+		// child chain of records, primary chain if empty
+		borChainID := vars.Get("bor_chain_id")
+
 		if vars.Get("from-time") != "" && vars.Get("to-time") != "" {
 			// get from time (epoch)
 			fromTime, ok := rest.ParseInt64OrReturnBadRequest(w, vars.Get("from-time"))
@@ -113,7 +115,7 @@ func recordListHandlerFn(
 			}
 
 			// get result by time-range query
-			res, err = timeRangeQuery(cliCtx, fromTime, toTime, page, limit)
+			res, err = timeRangeQuery(cliCtx, fromTime, toTime, page, limit, borChainID)
 
 		} else if vars.Get("from-id") != "" && vars.Get("to-time") != "" {
 			// get from id
@@ -129,10 +131,10 @@ func recordListHandlerFn(
 			}
 
 			// get result by till time-range query
-			res, err = tillTimeRangeQuery(cliCtx, fromID, toTime, limit)
+			res, err = tillTimeRangeQuery(cliCtx, fromID, toTime, limit, borChainID)
 		} else {
 			// get result by range query
-			res, err = rangeQuery(cliCtx, page, limit)
+			res, err = rangeQuery(cliCtx, page, limit, borChainID)
 		}
 
 		// send internal server error if error occured during the query
@@ -200,9 +202,9 @@ func DepositTxStatusHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 // Internal helpers
 //
 
-func recordQuery(cliCtx context.CLIContext, recordID uint64) ([]byte, error) {
+func recordQuery(cliCtx context.CLIContext, recordID uint64, borChainID string) ([]byte, error) {
 	// get query params
-	queryParams, err := cliCtx.Codec.MarshalJSON(types.NewQueryRecordParams(recordID))
+	queryParams, err := cliCtx.Codec.MarshalJSON(types.NewQueryRecordParams(recordID, borChainID))
 	if err != nil {
 		return nil, err
 	}
@@ -216,9 +218,9 @@ func recordQuery(cliCtx context.CLIContext, recordID uint64) ([]byte, error) {
 	return res, nil
 }
 
-func timeRangeQuery(cliCtx context.CLIContext, fromTime int64, toTime int64, page uint64, limit uint64) ([]byte, error) {
+func timeRangeQuery(cliCtx context.CLIContext, fromTime int64, toTime int64, page uint64, limit uint64, borChainID string) ([]byte, error) {
 	// get query params
-	queryParams, err := cliCtx.Codec.MarshalJSON(types.NewQueryTimeRangePaginationParams(time.Unix(fromTime, 0), time.Unix(toTime, 0), page, limit))
+	queryParams, err := cliCtx.Codec.MarshalJSON(types.NewQueryTimeRangePaginationParams(time.Unix(fromTime, 0), time.Unix(toTime, 0), page, limit, borChainID))
 	if err != nil {
 		return nil, err
 	}
@@ -236,9 +238,9 @@ func timeRangeQuery(cliCtx context.CLIContext, fromTime int64, toTime int64, pag
 	return res, nil
 }
 
-func rangeQuery(cliCtx context.CLIContext, page uint64, limit uint64) ([]byte, error) {
+func rangeQuery(cliCtx context.CLIContext, page uint64, limit uint64, borChainID string) ([]byte, error) {
 	// get query params
-	queryParams, err := cliCtx.Codec.MarshalJSON(hmTypes.NewQueryPaginationParams(page, limit))
+	queryParams, err := cliCtx.Codec.MarshalJSON(types.NewQueryRecordListParams(page, limit, borChainID))
 	if err != nil {
 		return nil, err
 	}
@@ -256,11 +258,11 @@ func rangeQuery(cliCtx context.CLIContext, page uint64, limit uint64) ([]byte, e
 	return res, nil
 }
 
-func tillTimeRangeQuery(cliCtx context.CLIContext, fromID uint64, toTime int64, limit uint64) ([]byte, error) {
+func tillTimeRangeQuery(cliCtx context.CLIContext, fromID uint64, toTime int64, limit uint64, borChainID string) ([]byte, error) {
 	result := make([]*types.EventRecord, 0, limit)
 
 	// if from id not found, return empty result
-	fromData, err := recordQuery(cliCtx, fromID)
+	fromData, err := recordQuery(cliCtx, fromID, borChainID)
 	if err != nil {
 		return json.Marshal(result)
 	}
@@ -272,7 +274,7 @@ func tillTimeRangeQuery(cliCtx context.CLIContext, fromID uint64, toTime int64,
 	}
 
 	fromTime := fromRecord.RecordTime.Unix()
-	rangeData, err := timeRangeQuery(cliCtx, fromTime, toTime, 1, limit)
+	rangeData, err := timeRangeQuery(cliCtx, fromTime, toTime, 1, limit, borChainID)
 	if err != nil {
 		return nil, err
 	}
@@ -295,7 +297,7 @@ func tillTimeRangeQuery(cliCtx context.CLIContext, fromID uint64, toTime int64,
 			result = append(result, found)
 		} else {
 			// fetch record for nextID and unmarshal to record
-			recordData, err := recordQuery(cliCtx, nextID)
+			recordData, err := recordQuery(cliCtx, nextID, borChainID)
 			if err != nil {
 				break
 			}
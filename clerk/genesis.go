@@ -25,5 +25,11 @@ func InitGenesis(ctx sdk.Context, keeper Keeper, data types.GenesisState) {
 
 // ExportGenesis returns a GenesisState for a given context and keeper.
 func ExportGenesis(ctx sdk.Context, keeper Keeper) types.GenesisState {
-	return types.NewGenesisState(keeper.GetAllEventRecords(ctx), keeper.GetRecordSequences(ctx))
+	// records of secondary child chains are routed back to their store by chain id on import
+	records := keeper.GetAllEventRecords(ctx)
+	for _, childChain := range keeper.chainKeeper.GetChildChains(ctx) {
+		records = append(records, keeper.GetAllEventRecordsForChain(ctx, childChain.BorChainID)...)
+	}
+
+	return types.NewGenesisState(records, keeper.GetRecordSequences(ctx))
 }
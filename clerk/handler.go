@@ -38,21 +38,20 @@ func handleMsgEventRecord(ctx sdk.Context, msg types.MsgEventRecord, k Keeper, c
 		"blockNumber", msg.BlockNumber,
 	)
 
-	// check if event record exists
-	if exists := k.HasEventRecord(ctx, msg.ID); exists {
-		return types.ErrEventRecordAlreadySynced(k.Codespace()).Result()
-	}
-
 	// chainManager params
 	params := k.chainKeeper.GetParams(ctx)
-	chainParams := params.ChainParams
 
-	// check chain id
-	if chainParams.BorChainID != msg.ChainID {
+	// check chain id is one of registered child chains
+	if _, ok := params.GetChildChain(msg.ChainID); !ok {
 		k.Logger(ctx).Error("Invalid Bor chain id", "msgChainID", msg.ChainID)
 		return common.ErrInvalidBorChainID(k.Codespace()).Result()
 	}
 
+	// check if event record of child chain exists
+	if exists := k.HasEventRecordForChain(ctx, msg.ChainID, msg.ID); exists {
+		return types.ErrEventRecordAlreadySynced(k.Codespace()).Result()
+	}
+
 	// sequence id
 	blockNumber := new(big.Int).SetUint64(msg.BlockNumber)
 	sequence := new(big.Int).Mul(blockNumber, big.NewInt(hmTypes.DefaultLogIndexUnit))
@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/tendermint/tendermint/libs/log"
 
@@ -25,6 +26,8 @@ var (
 	RecordSequencePrefixKey = []byte{0x12}
 
 	StateRecordPrefixKeyWithTime = []byte{0x13} // prefix key for when storing state with time
+
+	ChildChainKey = []byte{0x14} // prefix key to store records of secondary child chains
 )
 
 // Keeper stores all related data
@@ -68,6 +71,17 @@ func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", types.ModuleName)
 }
 
+// chainStore returns record store of child chain.
+// Primary chain (and unregistered chain id) uses module store, secondary child chains use prefixed store.
+func (k *Keeper) chainStore(ctx sdk.Context, borChainID string) sdk.KVStore {
+	store := ctx.KVStore(k.storeKey)
+	if borChainID == "" || !k.chainKeeper.IsSecondaryChain(ctx, borChainID) {
+		return store
+	}
+
+	return prefix.NewStore(store, GetChildChainKey(borChainID))
+}
+
 // SetEventRecordWithTime sets event record id with time
 func (k *Keeper) SetEventRecordWithTime(ctx sdk.Context, record types.EventRecord) error {
 	key := GetEventRecordKeyWithTime(record.ID, record.RecordTime)
@@ -77,7 +91,7 @@ func (k *Keeper) SetEventRecordWithTime(ctx sdk.Context, record types.EventRecor
 		return err
 	}
 
-	if err := k.setEventRecordStore(ctx, key, value); err != nil {
+	if err := k.setEventRecordStore(ctx, record.ChainID, key, value); err != nil {
 		return err
 	}
 	return nil
@@ -92,15 +106,15 @@ func (k *Keeper) SetEventRecordWithID(ctx sdk.Context, record types.EventRecord)
 		return err
 	}
 
-	if err := k.setEventRecordStore(ctx, key, value); err != nil {
+	if err := k.setEventRecordStore(ctx, record.ChainID, key, value); err != nil {
 		return err
 	}
 	return nil
 }
 
-// setEventRecordStore adds value to store by key
-func (k *Keeper) setEventRecordStore(ctx sdk.Context, key, value []byte) error {
-	store := ctx.KVStore(k.storeKey)
+// setEventRecordStore adds value to store of child chain by key
+func (k *Keeper) setEventRecordStore(ctx sdk.Context, borChainID string, key, value []byte) error {
+	store := k.chainStore(ctx, borChainID)
 	// check if already set
 	if store.Has(key) {
 		return errors.New("Key already exists")
@@ -113,7 +127,7 @@ func (k *Keeper) setEventRecordStore(ctx sdk.Context, key, value []byte) error {
 	return nil
 }
 
-// SetEventRecord adds record to store
+// SetEventRecord adds record to store of its child chain
 func (k *Keeper) SetEventRecord(ctx sdk.Context, record types.EventRecord) error {
 	if err := k.SetEventRecordWithID(ctx, record); err != nil {
 		return err
@@ -126,7 +140,12 @@ func (k *Keeper) SetEventRecord(ctx sdk.Context, record types.EventRecord) error
 
 // GetEventRecord returns record from store
 func (k *Keeper) GetEventRecord(ctx sdk.Context, stateID uint64) (*types.EventRecord, error) {
-	store := ctx.KVStore(k.storeKey)
+	return k.GetEventRecordForChain(ctx, "", stateID)
+}
+
+// GetEventRecordForChain returns record of child chain from store
+func (k *Keeper) GetEventRecordForChain(ctx sdk.Context, borChainID string, stateID uint64) (*types.EventRecord, error) {
+	store := k.chainStore(ctx, borChainID)
 	key := GetEventRecordKey(stateID)
 
 	// check store has data
@@ -146,15 +165,25 @@ func (k *Keeper) GetEventRecord(ctx sdk.Context, stateID uint64) (*types.EventRe
 
 // HasEventRecord check if state record
 func (k *Keeper) HasEventRecord(ctx sdk.Context, stateID uint64) bool {
-	store := ctx.KVStore(k.storeKey)
+	return k.HasEventRecordForChain(ctx, "", stateID)
+}
+
+// HasEventRecordForChain check if state record of child chain exists
+func (k *Keeper) HasEventRecordForChain(ctx sdk.Context, borChainID string, stateID uint64) bool {
+	store := k.chainStore(ctx, borChainID)
 	key := GetEventRecordKey(stateID)
 	return store.Has(key)
 }
 
 // GetAllEventRecords get all state records
 func (k *Keeper) GetAllEventRecords(ctx sdk.Context) (records []*types.EventRecord) {
+	return k.GetAllEventRecordsForChain(ctx, "")
+}
+
+// GetAllEventRecordsForChain get all state records of child chain
+func (k *Keeper) GetAllEventRecordsForChain(ctx sdk.Context, borChainID string) (records []*types.EventRecord) {
 	// iterate through spans and create span update array
-	k.IterateRecordsAndApplyFn(ctx, func(record types.EventRecord) error {
+	k.IterateRecordsForChainAndApplyFn(ctx, borChainID, func(record types.EventRecord) error {
 		// append to list of validatorUpdates
 		records = append(records, &record)
 		return nil
@@ -165,7 +194,12 @@ func (k *Keeper) GetAllEventRecords(ctx sdk.Context) (records []*types.EventReco
 
 // GetEventRecordList returns all records with params like page and limit
 func (k *Keeper) GetEventRecordList(ctx sdk.Context, page uint64, limit uint64) ([]types.EventRecord, error) {
-	store := ctx.KVStore(k.storeKey)
+	return k.GetEventRecordListForChain(ctx, "", page, limit)
+}
+
+// GetEventRecordListForChain returns all records of child chain with params like page and limit
+func (k *Keeper) GetEventRecordListForChain(ctx sdk.Context, borChainID string, page uint64, limit uint64) ([]types.EventRecord, error) {
+	store := k.chainStore(ctx, borChainID)
 
 	// create records
 	var records []types.EventRecord
@@ -191,8 +225,13 @@ func (k *Keeper) GetEventRecordList(ctx sdk.Context, page uint64, limit uint64)
 
 // GetEventRecordListWithTime returns all records with params like fromTime and toTime
 func (k *Keeper) GetEventRecordListWithTime(ctx sdk.Context, fromTime, toTime time.Time, page, limit uint64) ([]types.EventRecord, error) {
+	return k.GetEventRecordListWithTimeForChain(ctx, "", fromTime, toTime, page, limit)
+}
+
+// GetEventRecordListWithTimeForChain returns all records of child chain with params like fromTime and toTime
+func (k *Keeper) GetEventRecordListWithTimeForChain(ctx sdk.Context, borChainID string, fromTime, toTime time.Time, page, limit uint64) ([]types.EventRecord, error) {
 	var iterator sdk.Iterator
-	store := ctx.KVStore(k.storeKey)
+	store := k.chainStore(ctx, borChainID)
 
 	// create records
 	var records []types.EventRecord
@@ -214,7 +253,7 @@ func (k *Keeper) GetEventRecordListWithTime(ctx sdk.Context, fromTime, toTime ti
 	for ; iterator.Valid(); iterator.Next() {
 		var stateID uint64
 		if err := k.cdc.UnmarshalBinaryBare(iterator.Value(), &stateID); err == nil {
-			record, err := k.GetEventRecord(ctx, stateID)
+			record, err := k.GetEventRecordForChain(ctx, borChainID, stateID)
 			if err != nil {
 				k.Logger(ctx).Error("GetEventRecordListWithTime | GetEventRecord", "error", err)
 				continue
@@ -230,6 +269,13 @@ func (k *Keeper) GetEventRecordListWithTime(ctx sdk.Context, fromTime, toTime ti
 // GetEventRecordKey returns key for state record
 //
 
+// GetChildChainKey returns store prefix of secondary child chain (length prefixed chain id)
+func GetChildChainKey(borChainID string) []byte {
+	key := append([]byte{}, ChildChainKey...)
+	key = append(key, byte(len(borChainID)))
+	return append(key, []byte(borChainID)...)
+}
+
 // GetEventRecordKey appends prefix to state id
 func GetEventRecordKey(stateID uint64) []byte {
 	stateIDBytes := []byte(strconv.FormatUint(stateID, 10))
@@ -259,7 +305,12 @@ func GetRecordSequenceKey(sequence string) []byte {
 
 // IterateRecordsAndApplyFn interate records and apply the given function.
 func (k *Keeper) IterateRecordsAndApplyFn(ctx sdk.Context, f func(record types.EventRecord) error) {
-	store := ctx.KVStore(k.storeKey)
+	k.IterateRecordsForChainAndApplyFn(ctx, "", f)
+}
+
+// IterateRecordsForChainAndApplyFn interate records of child chain and apply the given function.
+func (k *Keeper) IterateRecordsForChainAndApplyFn(ctx sdk.Context, borChainID string, f func(record types.EventRecord) error) {
+	store := k.chainStore(ctx, borChainID)
 
 	// get span iterator
 	iterator := sdk.KVStorePrefixIterator(store, StateRecordPrefixKey)
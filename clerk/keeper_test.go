@@ -9,6 +9,7 @@ import (
 	"github.com/stretchr/testify/suite"
 
 	"github.com/maticnetwork/heimdall/app"
+	chainmanagerTypes "github.com/maticnetwork/heimdall/chainmanager/types"
 	"github.com/maticnetwork/heimdall/clerk"
 	"github.com/maticnetwork/heimdall/clerk/types"
 	hmTypes "github.com/maticnetwork/heimdall/types"
@@ -154,3 +155,51 @@ func (suite *KeeperTestSuite) TestSetHasGetRecordSequence() {
 	recordSequences := ck.GetRecordSequences(ctx)
 	require.Len(t, recordSequences, 1)
 }
+
+func (suite *KeeperTestSuite) TestChildChainEventRecords() {
+	t, app, ctx := suite.T(), suite.app, suite.ctx
+	ck := app.ClerkKeeper
+
+	// register secondary child chain
+	chainParams := app.ChainKeeper.GetParams(ctx)
+	chainParams.ChildChains = []chainmanagerTypes.ChildChainParams{
+		{
+			BorChainID:           "80002",
+			RootChainAddress:     hmTypes.HexToHeimdallAddress("0x0000000000000000000000000000000000002000"),
+			StateSenderAddress:   hmTypes.HexToHeimdallAddress("0x0000000000000000000000000000000000002001"),
+			StateReceiverAddress: chainmanagerTypes.DefaultStateReceiverAddress,
+			ValidatorSetAddress:  chainmanagerTypes.DefaultValidatorSetAddress,
+		},
+	}
+	app.ChainKeeper.SetParams(ctx, chainParams)
+
+	hAddr := hmTypes.BytesToHeimdallAddress([]byte("some-address"))
+	primaryRecord := types.NewEventRecord(hmTypes.HexToHeimdallHash("0x01"), 1, 1, hAddr, make([]byte, 0), chainParams.ChainParams.BorChainID, time.Unix(1, 0))
+	childRecord := types.NewEventRecord(hmTypes.HexToHeimdallHash("0x02"), 1, 1, hAddr, make([]byte, 0), "80002", time.Unix(2, 0))
+
+	// records with same id are kept per chain
+	require.NoError(t, ck.SetEventRecord(ctx, primaryRecord))
+	require.NoError(t, ck.SetEventRecord(ctx, childRecord))
+	require.Error(t, ck.SetEventRecord(ctx, childRecord))
+
+	record, err := ck.GetEventRecord(ctx, 1)
+	require.NoError(t, err)
+	require.Equal(t, primaryRecord.TxHash, record.TxHash)
+
+	record, err = ck.GetEventRecordForChain(ctx, "80002", 1)
+	require.NoError(t, err)
+	require.Equal(t, childRecord.TxHash, record.TxHash)
+	require.False(t, ck.HasEventRecordForChain(ctx, "80002", 2))
+
+	require.Len(t, ck.GetAllEventRecords(ctx), 1)
+	recordList, err := ck.GetEventRecordListForChain(ctx, "80002", 1, 10)
+	require.NoError(t, err)
+	require.Len(t, recordList, 1)
+	recordList, err = ck.GetEventRecordListWithTimeForChain(ctx, "80002", time.Unix(0, 0), time.Unix(10, 0), 0, 0)
+	require.NoError(t, err)
+	require.Len(t, recordList, 1)
+	require.Equal(t, "80002", recordList[0].ChainID)
+
+	// records of all chains are exported
+	require.Len(t, clerk.ExportGenesis(ctx, ck).EventRecords, 2)
+}
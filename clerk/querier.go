@@ -39,7 +39,7 @@ func handleQueryRecord(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([
 	}
 
 	// get state record by record id
-	record, err := keeper.GetEventRecord(ctx, params.RecordID)
+	record, err := keeper.GetEventRecordForChain(ctx, params.BorChainID, params.RecordID)
 	if err != nil {
 		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not get state record", err.Error()))
 	}
@@ -53,12 +53,12 @@ func handleQueryRecord(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([
 }
 
 func handleQueryRecordList(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
-	var params hmTypes.QueryPaginationParams
+	var params types.QueryRecordListParams
 	if err := keeper.cdc.UnmarshalJSON(req.Data, &params); err != nil {
 		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
 	}
 
-	res, err := keeper.GetEventRecordList(ctx, params.Page, params.Limit)
+	res, err := keeper.GetEventRecordListForChain(ctx, params.BorChainID, params.Page, params.Limit)
 	if err != nil {
 		return nil, sdk.ErrInternal(sdk.AppendMsgToErr(fmt.Sprintf("could not fetch record list with page %v and limit %v", params.Page, params.Limit), err.Error()))
 	}
@@ -75,7 +75,7 @@ func handleQueryRecordListWithTime(ctx sdk.Context, req abci.RequestQuery, keepe
 	if err := types.ModuleCdc.UnmarshalJSON(req.Data, &params); err != nil {
 		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
 	}
-	res, err := keeper.GetEventRecordListWithTime(ctx, params.FromTime, params.ToTime, params.Page, params.Limit)
+	res, err := keeper.GetEventRecordListWithTimeForChain(ctx, params.BorChainID, params.FromTime, params.ToTime, params.Page, params.Limit)
 	if err != nil {
 		return nil, sdk.ErrInternal(sdk.AppendMsgToErr(fmt.Sprintf("could not fetch record list with fromTime %v and toTime %v", params.FromTime, params.ToTime), err.Error()))
 	}
@@ -77,7 +77,7 @@ func (suite *QuerierTestSuite) TestHandleQueryRecord() {
 
 	req = abci.RequestQuery{
 		Path: route,
-		Data: app.Codec().MustMarshalJSON(types.NewQueryRecordParams(2)),
+		Data: app.Codec().MustMarshalJSON(types.NewQueryRecordParams(2, "")),
 	}
 	_, err = querier(ctx, path, req)
 	require.Error(t, err, "could not get state record")
@@ -92,7 +92,7 @@ func (suite *QuerierTestSuite) TestHandleQueryRecord() {
 
 	req = abci.RequestQuery{
 		Path: route,
-		Data: app.Codec().MustMarshalJSON(types.NewQueryRecordParams(1)),
+		Data: app.Codec().MustMarshalJSON(types.NewQueryRecordParams(1, "")),
 	}
 	record, err := querier(ctx, path, req)
 	require.NoError(t, err)
@@ -54,7 +54,13 @@ func SideHandleMsgEventRecord(ctx sdk.Context, k Keeper, msg types.MsgEventRecor
 
 	// chainManager params
 	params := k.chainKeeper.GetParams(ctx)
-	chainParams := params.ChainParams
+
+	// contract addresses of child chain the record is synced to
+	childChain, ok := params.GetChildChain(msg.ChainID)
+	if !ok {
+		k.Logger(ctx).Error("Invalid Bor chain id", "msgChainID", msg.ChainID)
+		return hmCommon.ErrorSideTx(k.Codespace(), common.CodeInvalidBorChainID)
+	}
 
 	// get confirmed tx receipt
 	receipt, err := contractCaller.GetConfirmedTxReceipt(msg.TxHash.EthHash(), params.MainchainTxConfirmations)
@@ -63,7 +69,7 @@ func SideHandleMsgEventRecord(ctx sdk.Context, k Keeper, msg types.MsgEventRecor
 	}
 
 	// get event log for topup
-	eventLog, err := contractCaller.DecodeStateSyncedEvent(chainParams.StateSenderAddress.EthAddress(), receipt, msg.LogIndex)
+	eventLog, err := contractCaller.DecodeStateSyncedEvent(childChain.StateSenderAddress.EthAddress(), receipt, msg.LogIndex)
 	if err != nil || eventLog == nil {
 		k.Logger(ctx).Error("Error fetching log from txhash")
 		return hmCommon.ErrorSideTx(k.Codespace(), common.CodeErrDecodeEvent)
@@ -111,7 +117,7 @@ func PostHandleMsgEventRecord(ctx sdk.Context, k Keeper, msg types.MsgEventRecor
 	}
 
 	// check for replay
-	if k.HasEventRecord(ctx, msg.ID) {
+	if k.HasEventRecordForChain(ctx, msg.ChainID, msg.ID) {
 		k.Logger(ctx).Debug("Skipping new clerk record as it's already processed")
 		return hmCommon.ErrOldTx(k.Codespace()).Result()
 	}
@@ -14,6 +14,7 @@ import (
 
 	ethTypes "github.com/maticnetwork/bor/core/types"
 	"github.com/maticnetwork/heimdall/app"
+	chainmanagerTypes "github.com/maticnetwork/heimdall/chainmanager/types"
 	"github.com/maticnetwork/heimdall/clerk"
 	"github.com/maticnetwork/heimdall/clerk/types"
 	"github.com/maticnetwork/heimdall/common"
@@ -177,6 +178,65 @@ func (suite *SideHandlerTestSuite) TestSideHandleMsgEventRecord() {
 		require.NotEqual(t, uint32(sdk.CodeOK), result.Code, "Side tx handler should fail")
 		require.Equal(t, abci.SideTxResultType_Skip, result.Result, "Result should be `skip`")
 	})
+
+	t.Run("ChildChain", func(t *testing.T) {
+		suite.contractCaller = mocks.IContractCaller{}
+
+		// register secondary child chain with its own state sender
+		childChainParams := chainParams
+		childChainParams.ChildChains = []chainmanagerTypes.ChildChainParams{
+			{
+				BorChainID:           "80002",
+				RootChainAddress:     hmTypes.HexToHeimdallAddress("0x0000000000000000000000000000000000002000"),
+				StateSenderAddress:   hmTypes.HexToHeimdallAddress("0x0000000000000000000000000000000000002001"),
+				StateReceiverAddress: chainmanagerTypes.DefaultStateReceiverAddress,
+				ValidatorSetAddress:  chainmanagerTypes.DefaultValidatorSetAddress,
+			},
+		}
+		app.ChainKeeper.SetParams(ctx, childChainParams)
+
+		logIndex := uint64(20)
+		blockNumber := uint64(600)
+		txReceipt := &ethTypes.Receipt{
+			BlockNumber: new(big.Int).SetUint64(blockNumber),
+		}
+		txHash := hmTypes.HexToHeimdallHash("child chain hash")
+
+		msg := types.NewMsgEventRecord(
+			hmTypes.BytesToHeimdallAddress(addr1.Bytes()),
+			txHash,
+			logIndex,
+			blockNumber,
+			id,
+			hmTypes.BytesToHeimdallAddress(addr1.Bytes()),
+			make([]byte, 0),
+			"80002",
+		)
+
+		// state synced event is decoded from state sender of child chain
+		suite.contractCaller.On("GetConfirmedTxReceipt", txHash.EthHash(), chainParams.MainchainTxConfirmations).Return(txReceipt, nil)
+		event := &statesender.StatesenderStateSynced{
+			Id:              new(big.Int).SetUint64(msg.ID),
+			ContractAddress: msg.ContractAddress.EthAddress(),
+			Data:            msg.Data,
+		}
+		suite.contractCaller.On("DecodeStateSyncedEvent", childChainParams.ChildChains[0].StateSenderAddress.EthAddress(), txReceipt, logIndex).Return(event, nil)
+
+		result := suite.sideHandler(ctx, msg)
+		require.Equal(t, uint32(sdk.CodeOK), result.Code, "Side tx handler should be success")
+		require.Equal(t, abci.SideTxResultType_Yes, result.Result, "Result should be `yes`")
+
+		// record is stored for child chain only
+		result2 := suite.postHandler(ctx, msg, abci.SideTxResultType_Yes)
+		require.True(t, result2.IsOK(), "Post handler should succeed")
+		require.True(t, app.ClerkKeeper.HasEventRecordForChain(ctx, "80002", id))
+		require.False(t, app.ClerkKeeper.HasEventRecord(ctx, id))
+
+		// unregistered chain is rejected
+		msg.ChainID = "80003"
+		result = suite.sideHandler(ctx, msg)
+		require.Equal(t, uint32(common.CodeInvalidBorChainID), result.Code)
+	})
 }
 
 func (suite *SideHandlerTestSuite) TestPostHandler() {
@@ -14,7 +14,8 @@ const (
 
 // QueryRecordParams defines the params for querying accounts.
 type QueryRecordParams struct {
-	RecordID uint64
+	RecordID   uint64
+	BorChainID string
 }
 
 // QueryRecordSequenceParams defines the params for querying an account Sequence.
@@ -25,15 +26,23 @@ type QueryRecordSequenceParams struct {
 
 // QueryRecordTimePaginationParams defines the params for querying records with time.
 type QueryRecordTimePaginationParams struct {
-	FromTime time.Time
-	ToTime   time.Time
-	Page     uint64
-	Limit    uint64
+	FromTime   time.Time
+	ToTime     time.Time
+	Page       uint64
+	Limit      uint64
+	BorChainID string
+}
+
+// QueryRecordListParams defines the params for querying records of child chain with page and limit.
+type QueryRecordListParams struct {
+	Page       uint64
+	Limit      uint64
+	BorChainID string
 }
 
 // NewQueryRecordParams creates a new instance of QueryRecordParams.
-func NewQueryRecordParams(recordID uint64) QueryRecordParams {
-	return QueryRecordParams{RecordID: recordID}
+func NewQueryRecordParams(recordID uint64, borChainID string) QueryRecordParams {
+	return QueryRecordParams{RecordID: recordID, BorChainID: borChainID}
 }
 
 // NewQueryRecordSequenceParams creates a new instance of QuerySequenceParams.
@@ -42,6 +51,11 @@ func NewQueryRecordSequenceParams(txHash string, logIndex uint64) QueryRecordSeq
 }
 
 // NewQueryTimeRangePaginationParams creates a new instance of NewQueryTimeRangePaginationParams.
-func NewQueryTimeRangePaginationParams(fromTime, toTime time.Time, page, limit uint64) QueryRecordTimePaginationParams {
-	return QueryRecordTimePaginationParams{FromTime: fromTime, ToTime: toTime, Page: page, Limit: limit}
+func NewQueryTimeRangePaginationParams(fromTime, toTime time.Time, page, limit uint64, borChainID string) QueryRecordTimePaginationParams {
+	return QueryRecordTimePaginationParams{FromTime: fromTime, ToTime: toTime, Page: page, Limit: limit, BorChainID: borChainID}
+}
+
+// NewQueryRecordListParams creates a new instance of QueryRecordListParams.
+func NewQueryRecordListParams(page, limit uint64, borChainID string) QueryRecordListParams {
+	return QueryRecordListParams{Page: page, Limit: limit, BorChainID: borChainID}
 }
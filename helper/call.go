@@ -4,14 +4,19 @@ import (
 	"bytes"
 	"context"
 	"errors"
+	"fmt"
 	"math/big"
 	"strings"
+	"sync"
 
 	lru "github.com/hashicorp/golang-lru"
 	"github.com/maticnetwork/bor/accounts/abi"
 	"github.com/maticnetwork/bor/common"
+	"github.com/maticnetwork/bor/common/hexutil"
 	ethTypes "github.com/maticnetwork/bor/core/types"
+	"github.com/maticnetwork/bor/crypto"
 	"github.com/maticnetwork/bor/ethclient"
+	"github.com/maticnetwork/bor/rlp"
 	"github.com/maticnetwork/bor/rpc"
 	"github.com/maticnetwork/heimdall/contracts/erc20"
 	"github.com/maticnetwork/heimdall/contracts/rootchain"
@@ -26,6 +31,9 @@ import (
 	"github.com/maticnetwork/heimdall/types"
 )
 
+// maticHeadersBatchSize is the number of headers fetched in one batch request
+const maticHeadersBatchSize = 100
+
 // IContractCaller represents contract caller
 type IContractCaller interface {
 	GetHeaderInfo(headerID uint64, rootChainInstance *rootchain.Rootchain, childBlockInterval uint64) (root common.Hash, start, end, createdAt uint64, proposer types.HeimdallAddress, err error)
@@ -39,6 +47,8 @@ type IContractCaller interface {
 	GetCheckpointSign(txHash common.Hash) ([]byte, []byte, []byte, error)
 	GetMainChainBlock(*big.Int) (*ethTypes.Header, error)
 	GetMaticChainBlock(*big.Int) (*ethTypes.Header, error)
+	GetMaticChainHeaders(start uint64, end uint64) ([]*ethTypes.Header, error)
+	GetChildChainCaller(borChainID string) (IContractCaller, error)
 	IsTxConfirmed(common.Hash, uint64) bool
 	GetConfirmedTxReceipt(common.Hash, uint64) (*ethTypes.Receipt, error)
 	GetBlockNumberFromTxHash(common.Hash) (*big.Int, error)
@@ -87,6 +97,10 @@ type ContractCaller struct {
 	MaticChainClient *ethclient.Client
 	MaticChainRPC    *rpc.Client
 
+	// RPC pools (nil with single endpoint)
+	MainChainPool  *RPCPool
+	MaticChainPool *RPCPool
+
 	RootChainABI     abi.ABI
 	StakingInfoABI   abi.ABI
 	ValidatorSetABI  abi.ABI
@@ -117,6 +131,8 @@ func NewContractCaller() (contractCallerObj ContractCaller, err error) {
 	contractCallerObj.MaticChainClient = GetMaticClient()
 	contractCallerObj.MainChainRPC = GetMainChainRPCClient()
 	contractCallerObj.MaticChainRPC = GetMaticRPCClient()
+	contractCallerObj.MainChainPool = GetMainChainRPCPool()
+	contractCallerObj.MaticChainPool = GetMaticRPCPool()
 	contractCallerObj.ReceiptCache, _ = NewLru(1000)
 
 	//
@@ -382,6 +398,48 @@ func (c *ContractCaller) GetMaticChainBlock(blockNum *big.Int) (header *ethTypes
 	return latestBlock, nil
 }
 
+// GetMaticChainHeaders returns child chain block headers from start to end (inclusive)
+func (c *ContractCaller) GetMaticChainHeaders(start uint64, end uint64) ([]*ethTypes.Header, error) {
+	if start > end {
+		return nil, errors.New("start is greater than end")
+	}
+
+	headers := make([]*ethTypes.Header, end-start+1)
+	elements := make([]rpc.BatchElem, 0, len(headers))
+	for i := range headers {
+		elements = append(elements, rpc.BatchElem{
+			Method: "eth_getBlockByNumber",
+			Args:   []interface{}{hexutil.EncodeUint64(start + uint64(i)), false},
+			Result: &headers[i],
+		})
+	}
+
+	// fetch headers in batches
+	for i := 0; i < len(elements); i += maticHeadersBatchSize {
+		batchEnd := i + maticHeadersBatchSize
+		if batchEnd > len(elements) {
+			batchEnd = len(elements)
+		}
+
+		if err := c.MaticChainRPC.BatchCall(elements[i:batchEnd]); err != nil {
+			Logger.Error("Unable to fetch headers from matic chain", "start", start, "end", end, "Error", err)
+			return nil, err
+		}
+	}
+
+	for i, element := range elements {
+		if element.Error != nil {
+			return nil, element.Error
+		}
+
+		if headers[i] == nil {
+			return nil, fmt.Errorf("block %v not found on matic chain", start+uint64(i))
+		}
+	}
+
+	return headers, nil
+}
+
 // GetBlockNumberFromTxHash gets block number of transaction
 func (c *ContractCaller) GetBlockNumberFromTxHash(tx common.Hash) (*big.Int, error) {
 	var rpcTx rpcTransaction
@@ -421,8 +479,12 @@ func (c *ContractCaller) GetConfirmedTxReceipt(tx common.Hash, requiredConfirmat
 	if !ok {
 		var err error
 
-		// get main tx receipt
-		receipt, err = c.GetMainTxReceipt(tx)
+		// get main tx receipt (agreed by quorum of endpoints if enabled)
+		if quorum := GetConfig().MainchainRPCQuorum; quorum > 1 && c.MainChainPool != nil {
+			receipt, err = c.getTxReceiptWithQuorum(c.MainChainPool, tx, quorum)
+		} else {
+			receipt, err = c.GetMainTxReceipt(tx)
+		}
 		if err != nil {
 			Logger.Error("Error while fetching mainchain receipt", "error", err, "txHash", tx.Hex())
 			return nil, err
@@ -744,6 +806,60 @@ func (c *ContractCaller) getTxReceipt(client *ethclient.Client, txHash common.Ha
 	return client.TransactionReceipt(context.Background(), txHash)
 }
 
+// getTxReceiptWithQuorum fetches receipt from all endpoints of the pool and
+// returns it only if at least quorum endpoints return the same receipt
+func (c *ContractCaller) getTxReceiptWithQuorum(pool *RPCPool, txHash common.Hash, quorum uint64) (*ethTypes.Receipt, error) {
+	endpoints := pool.Endpoints()
+
+	receipts := make([]*ethTypes.Receipt, len(endpoints))
+	var wg sync.WaitGroup
+	for i, endpoint := range endpoints {
+		wg.Add(1)
+		go func(i int, endpoint *RPCEndpoint) {
+			defer wg.Done()
+
+			receipt, err := c.getTxReceipt(endpoint.Client, txHash)
+			if err != nil {
+				Logger.Debug("Error while fetching receipt from endpoint", "url", endpoint.URL, "txHash", txHash.Hex(), "error", err)
+				return
+			}
+			receipts[i] = receipt
+		}(i, endpoint)
+	}
+	wg.Wait()
+
+	// group receipts by block hash and consensus fields
+	votes := make(map[common.Hash]uint64)
+	for _, receipt := range receipts {
+		if receipt == nil {
+			continue
+		}
+
+		key, err := receiptQuorumKey(receipt)
+		if err != nil {
+			return nil, err
+		}
+
+		votes[key]++
+		if votes[key] >= quorum {
+			return receipt, nil
+		}
+	}
+
+	Logger.Error("RPC endpoints do not agree on receipt", "txHash", txHash.Hex(), "quorum", quorum, "endpoints", len(endpoints))
+	return nil, ErrRPCQuorumNotReached
+}
+
+// receiptQuorumKey returns hash of block hash and consensus fields of the receipt
+func receiptQuorumKey(receipt *ethTypes.Receipt) (common.Hash, error) {
+	data, err := rlp.EncodeToBytes(receipt)
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	return crypto.Keccak256Hash(receipt.BlockHash.Bytes(), data), nil
+}
+
 //
 // private abi methods
 //
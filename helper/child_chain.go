@@ -0,0 +1,64 @@
+package helper
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/maticnetwork/bor/ethclient"
+	"github.com/maticnetwork/bor/rpc"
+)
+
+// child chain clients, dialed on first use
+var (
+	childChainClientsMu sync.Mutex
+	childChainClients   = make(map[string]*rpc.Client)
+)
+
+// GetChildChainRPCUrls returns RPC endpoint(s) of secondary child chain from config
+func GetChildChainRPCUrls(borChainID string) (string, bool) {
+	for _, entry := range strings.Split(conf.ChildChainRPCUrls, ";") {
+		parts := strings.SplitN(strings.TrimSpace(entry), "=", 2)
+		if len(parts) == 2 && strings.TrimSpace(parts[0]) == borChainID {
+			return strings.TrimSpace(parts[1]), true
+		}
+	}
+	return "", false
+}
+
+// GetChildChainRPCClient returns RPC client of secondary child chain
+func GetChildChainRPCClient(borChainID string) (*rpc.Client, error) {
+	childChainClientsMu.Lock()
+	defer childChainClientsMu.Unlock()
+
+	if client, ok := childChainClients[borChainID]; ok {
+		return client, nil
+	}
+
+	urls, ok := GetChildChainRPCUrls(borChainID)
+	if !ok {
+		return nil, fmt.Errorf("No rpc url configured for child chain %v", borChainID)
+	}
+
+	client, _, err := dialRPC("bor-"+borChainID, urls)
+	if err != nil {
+		return nil, err
+	}
+
+	childChainClients[borChainID] = client
+	return client, nil
+}
+
+// GetChildChainCaller returns contract caller which reads bor data from given secondary child chain
+func (c *ContractCaller) GetChildChainCaller(borChainID string) (IContractCaller, error) {
+	client, err := GetChildChainRPCClient(borChainID)
+	if err != nil {
+		return nil, err
+	}
+
+	childChainCaller := *c
+	childChainCaller.MaticChainRPC = client
+	childChainCaller.MaticChainClient = ethclient.NewClient(client)
+	childChainCaller.MaticChainPool = nil
+	return &childChainCaller, nil
+}
@@ -1,7 +1,9 @@
 package helper
 
 import (
+	"context"
 	"crypto/ecdsa"
+	"fmt"
 	"log"
 	"math/big"
 	"os"
@@ -54,6 +56,8 @@ const (
 	DefaultHeimdallServerURL = "http://0.0.0.0:1317"
 	DefaultTendermintNodeURL = "http://0.0.0.0:26657"
 
+	DefaultBridgeMetricsListenAddr = "127.0.0.1:26661"
+
 	NoACKWaitTime = 1800 * time.Second // Time ack service waits to clear buffer and elect new proposer (1800 seconds ~ 30 mins)
 
 	DefaultCheckpointerPollInterval = 5 * time.Minute
@@ -64,8 +68,23 @@ const (
 
 	DefaultMainchainGasLimit = uint64(5000000)
 
+	DefaultMainchainMaxGasPrice = 400000000000 // 400 Gwei
+
+	DefaultMainchainLogsWindow    = uint64(1000) // blocks per eth_getLogs request
+	DefaultMainchainLogsRateLimit = uint64(10)   // eth_getLogs requests per second
+
+	// Bridge queue backends
+	AmqpQueueBackend    = "amqp"    // tasks are sent to RabbitMQ (amqp_url)
+	LevelDBQueueBackend = "leveldb" // tasks are stored in bridge db
+	MemoryQueueBackend  = "memory"  // tasks are processed in-process as soon as they are sent (tests)
+	DefaultQueueBackend = AmqpQueueBackend
+
 	DefaultBorChainID string = "15001"
 
+	DefaultNotifyRateLimit      = 10 * time.Minute
+	DefaultBalanceCheckInterval = 10 * time.Minute
+	DefaultMinFeeBalance        = "100000000000000000" // 0.1 matic, 100 txs with default tx fees
+
 	secretFilePerm = 0600
 )
 
@@ -85,14 +104,26 @@ func init() {
 
 // Configuration represents heimdall config
 type Configuration struct {
-	EthRPCUrl        string `mapstructure:"eth_rpc_url"`        // RPC endpoint for main chain
-	BorRPCUrl        string `mapstructure:"bor_rpc_url"`        // RPC endpoint for bor chain
+	EthRPCUrl        string `mapstructure:"eth_rpc_url"`        // RPC endpoint(s) for main chain (comma separated for failover)
+	BorRPCUrl        string `mapstructure:"bor_rpc_url"`        // RPC endpoint(s) for bor chain (comma separated for failover)
 	TendermintRPCUrl string `mapstructure:"tendermint_rpc_url"` // tendemint node url
 
+	ChildChainRPCUrls string `mapstructure:"child_chain_rpc_urls"` // RPC endpoint(s) of secondary child chains, <bor-chain-id>=<urls> separated by ;
+
+	RPCHealthCheckInterval time.Duration `mapstructure:"rpc_health_check_interval"` // health check interval for multiple main/bor RPC endpoints
+	MainchainRPCQuorum     uint64        `mapstructure:"main_chain_rpc_quorum"`     // number of main chain endpoints which must agree on critical reads (0 = disabled)
+
 	AmqpURL           string `mapstructure:"amqp_url"`             // amqp url
+	QueueBackend      string `mapstructure:"queue_backend"`        // bridge queue backend (amqp or leveldb)
 	HeimdallServerURL string `mapstructure:"heimdall_rest_server"` // heimdall server url
 
-	MainchainGasLimit uint64 `mapstructure:"main_chain_gas_limit"` // gas limit to mainchain transaction. eg....submit checkpoint.
+	BridgeMetricsListenAddr string `mapstructure:"bridge_metrics_listen_addr"` // bridge prometheus metrics listen address (empty to disable)
+
+	MainchainGasLimit    uint64 `mapstructure:"main_chain_gas_limit"`     // gas limit to mainchain transaction. eg....submit checkpoint.
+	MainchainMaxGasPrice int64  `mapstructure:"main_chain_max_gas_price"` // max gas price to mainchain transaction. eg....submit checkpoint.
+
+	MainchainLogsWindow    uint64 `mapstructure:"main_chain_logs_window"`     // max blocks queried per mainchain log filter request
+	MainchainLogsRateLimit uint64 `mapstructure:"main_chain_logs_rate_limit"` // max mainchain log filter requests per second (0 = unlimited)
 
 	// config related to bridge
 	CheckpointerPollInterval time.Duration `mapstructure:"checkpoint_poll_interval"` // Poll interval for checkpointer service to send new checkpoints or missing ACK
@@ -103,6 +134,18 @@ type Configuration struct {
 
 	// wait time related options
 	NoACKWaitTime time.Duration `mapstructure:"no_ack_wait_time"` // Time ack service waits to clear buffer and elect new proposer
+
+	// signer for bridge and cli txs
+	SignerType         string `mapstructure:"signer_type"`          // local (priv_validator_key.json), keystore or remote
+	SignerKeystoreFile string `mapstructure:"signer_keystore_file"` // encrypted keystore file for keystore signer
+	SignerPasswordFile string `mapstructure:"signer_password_file"` // file with keystore password for keystore signer
+	RemoteSignerURL    string `mapstructure:"remote_signer_url"`    // json-rpc url of remote signer
+
+	// bridge notifications
+	NotifyWebhooks       string        `mapstructure:"notify_webhooks"`        // webhook targets, comma separated <format>:<min severity>:<url>
+	NotifyRateLimit      time.Duration `mapstructure:"notify_rate_limit"`      // min interval between notifications of same type per webhook (0 = unlimited)
+	BalanceCheckInterval time.Duration `mapstructure:"balance_check_interval"` // interval to check fee token and main chain ETH balance (0 = disabled)
+	MinFeeBalance        string        `mapstructure:"min_fee_balance"`        // fee token balance below which bridge notifies
 }
 
 var conf Configuration
@@ -110,10 +153,12 @@ var conf Configuration
 // MainChainClient stores eth clie nt for Main chain Network
 var mainChainClient *ethclient.Client
 var mainRPCClient *rpc.Client
+var mainRPCPool *RPCPool
 
 // MaticClient stores eth/rpc client for Matic Network
 var maticClient *ethclient.Client
 var maticRPCClient *rpc.Client
+var maticRPCPool *RPCPool
 
 var maticEthClient *eth.EthAPIBackend
 
@@ -175,12 +220,16 @@ func InitHeimdallConfigWith(homeDir string, heimdallConfigFilePath string) {
 		log.Fatalln("Unable to unmarshall config", "Error", err)
 	}
 
-	if mainRPCClient, err = rpc.Dial(conf.EthRPCUrl); err != nil {
+	if err = validateRPCQuorum(conf.MainchainRPCQuorum, conf.EthRPCUrl); err != nil {
+		log.Fatalln("Invalid main chain RPC quorum", "Error", err)
+	}
+
+	if mainRPCClient, mainRPCPool, err = dialRPC("eth", conf.EthRPCUrl); err != nil {
 		log.Fatalln("Unable to dial via ethClient", "URL=", conf.EthRPCUrl, "chain=eth", "Error", err)
 	}
 
 	mainChainClient = ethclient.NewClient(mainRPCClient)
-	if maticRPCClient, err = rpc.Dial(conf.BorRPCUrl); err != nil {
+	if maticRPCClient, maticRPCPool, err = dialRPC("bor", conf.BorRPCUrl); err != nil {
 		log.Fatal(err)
 	}
 
@@ -193,13 +242,20 @@ func InitHeimdallConfigWith(homeDir string, heimdallConfigFilePath string) {
 	GenesisDoc = *genDoc
 
 	// load pv file, unmarshall and set to privObject
-	err = file.PermCheck(file.Rootify("priv_validator_key.json", configDir), secretFilePerm)
-	if err != nil {
-		Logger.Error(err.Error())
+	// pv file is optional for keystore and remote signers
+	pvFile := filepath.Join(configDir, "priv_validator_key.json")
+	if _, statErr := os.Stat(pvFile); conf.SignerType == "" || conf.SignerType == LocalSigner || statErr == nil {
+		err = file.PermCheck(file.Rootify("priv_validator_key.json", configDir), secretFilePerm)
+		if err != nil {
+			Logger.Error(err.Error())
+		}
+		privVal := privval.LoadFilePV(pvFile, pvFile)
+		cdc.MustUnmarshalBinaryBare(privVal.Key.PrivKey.Bytes(), &privObject)
+		cdc.MustUnmarshalBinaryBare(privObject.PubKey().Bytes(), &pubObject)
 	}
-	privVal := privval.LoadFilePV(filepath.Join(configDir, "priv_validator_key.json"), filepath.Join(configDir, "priv_validator_key.json"))
-	cdc.MustUnmarshalBinaryBare(privVal.Key.PrivKey.Bytes(), &privObject)
-	cdc.MustUnmarshalBinaryBare(privObject.PubKey().Bytes(), &pubObject)
+
+	// signer for bridge and cli txs is created on first use (GetSigner)
+	SetSigner(nil)
 }
 
 // GetDefaultHeimdallConfig returns configration with default params
@@ -209,10 +265,19 @@ func GetDefaultHeimdallConfig() Configuration {
 		BorRPCUrl:        DefaultBorRPCUrl,
 		TendermintRPCUrl: DefaultTendermintNodeURL,
 
+		RPCHealthCheckInterval: DefaultRPCHealthCheckInterval,
+
 		AmqpURL:           DefaultAmqpURL,
+		QueueBackend:      DefaultQueueBackend,
 		HeimdallServerURL: DefaultHeimdallServerURL,
 
-		MainchainGasLimit: DefaultMainchainGasLimit,
+		BridgeMetricsListenAddr: DefaultBridgeMetricsListenAddr,
+
+		MainchainGasLimit:    DefaultMainchainGasLimit,
+		MainchainMaxGasPrice: DefaultMainchainMaxGasPrice,
+
+		MainchainLogsWindow:    DefaultMainchainLogsWindow,
+		MainchainLogsRateLimit: DefaultMainchainLogsRateLimit,
 
 		CheckpointerPollInterval: DefaultCheckpointerPollInterval,
 		SyncerPollInterval:       DefaultSyncerPollInterval,
@@ -221,6 +286,12 @@ func GetDefaultHeimdallConfig() Configuration {
 		SpanPollInterval:         DefaultSpanPollInterval,
 
 		NoACKWaitTime: NoACKWaitTime,
+
+		SignerType: LocalSigner,
+
+		NotifyRateLimit:      DefaultNotifyRateLimit,
+		BalanceCheckInterval: DefaultBalanceCheckInterval,
+		MinFeeBalance:        DefaultMinFeeBalance,
 	}
 }
 
@@ -239,6 +310,23 @@ func SetTestConfig(_conf Configuration) {
 	conf = _conf
 }
 
+// TEST PURPOSE ONLY
+// SetTestChainClients sets main and matic chain rpc clients
+func SetTestChainClients(mainRPC *rpc.Client, maticRPC *rpc.Client) {
+	mainRPCClient, mainRPCPool = mainRPC, nil
+	mainChainClient = ethclient.NewClient(mainRPC)
+	maticRPCClient, maticRPCPool = maticRPC, nil
+	maticClient = ethclient.NewClient(maticRPC)
+}
+
+// TEST PURPOSE ONLY
+// SetTestPrivKey sets validator private key and local signer
+func SetTestPrivKey(privKey secp256k1.PrivKeySecp256k1) {
+	privObject = privKey
+	cdc.MustUnmarshalBinaryBare(privObject.PubKey().Bytes(), &pubObject)
+	SetSigner(nil)
+}
+
 //
 // Get main/matic clients
 //
@@ -263,6 +351,57 @@ func GetMaticRPCClient() *rpc.Client {
 	return maticRPCClient
 }
 
+// GetMainChainRPCPool returns main chain RPC pool (nil with single endpoint)
+func GetMainChainRPCPool() *RPCPool {
+	return mainRPCPool
+}
+
+// GetMaticRPCPool returns matic's RPC pool (nil with single endpoint)
+func GetMaticRPCPool() *RPCPool {
+	return maticRPCPool
+}
+
+// validateRPCQuorum checks that quorum can be reached with configured endpoints
+func validateRPCQuorum(quorum uint64, urls string) error {
+	if quorum == 0 {
+		return nil
+	}
+
+	endpoints := uint64(len(splitRPCUrls(urls)))
+	if quorum > endpoints {
+		return fmt.Errorf("main_chain_rpc_quorum %v is greater than number of eth_rpc_url endpoints %v", quorum, endpoints)
+	}
+
+	return nil
+}
+
+// dialRPC dials single endpoint directly, or creates a failover pool for comma separated endpoints
+func dialRPC(name string, urls string) (*rpc.Client, *RPCPool, error) {
+	endpoints := splitRPCUrls(urls)
+	if len(endpoints) <= 1 {
+		client, err := rpc.Dial(urls)
+		return client, nil, err
+	}
+
+	pool, err := NewRPCPool(name, endpoints)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	client, err := pool.Client()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	interval := conf.RPCHealthCheckInterval
+	if interval == 0 {
+		interval = DefaultRPCHealthCheckInterval
+	}
+	go pool.StartHealthCheck(context.Background(), interval)
+
+	return client, pool, nil
+}
+
 // GetMaticEthClient returns matic's Eth client
 func GetMaticEthClient() *eth.EthAPIBackend {
 	return maticEthClient
@@ -284,7 +423,13 @@ func GetECDSAPrivKey() *ecdsa.PrivateKey {
 }
 
 // GetPubKey returns pub key object
+// Without validator key file (keystore and remote signers), public key of configured signer is returned.
 func GetPubKey() secp256k1.PubKeySecp256k1 {
+	if pubObject == (secp256k1.PubKeySecp256k1{}) && conf.SignerType != "" && conf.SignerType != LocalSigner {
+		if s, err := GetSigner(); err == nil {
+			return s.PubKey()
+		}
+	}
 	return pubObject
 }
 
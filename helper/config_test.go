@@ -6,6 +6,7 @@ import (
 	"testing"
 
 	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
 )
 
 //  Test - to check heimdall config
@@ -25,3 +26,14 @@ func TestHeimdallConfig(t *testing.T) {
 	fmt.Println("PublicKey", pubKey.String())
 	// fmt.Println("CryptoPublicKey", pubKey.CryptoPubKey().String())
 }
+
+func TestValidateRPCQuorum(t *testing.T) {
+	require.NoError(t, validateRPCQuorum(0, "http://localhost:8545"))
+	require.NoError(t, validateRPCQuorum(1, "http://localhost:8545"))
+	require.NoError(t, validateRPCQuorum(2, "http://a:8545,http://b:8545,http://c:8545"))
+	require.NoError(t, validateRPCQuorum(3, "http://a:8545,http://b:8545,http://c:8545"))
+
+	// quorum can never be reached
+	require.Error(t, validateRPCQuorum(2, "http://localhost:8545"))
+	require.Error(t, validateRPCQuorum(3, "http://a:8545,http://b:8545"))
+}
@@ -8,6 +8,8 @@ import (
 	common "github.com/maticnetwork/bor/common"
 	erc20 "github.com/maticnetwork/heimdall/contracts/erc20"
 
+	helper "github.com/maticnetwork/heimdall/helper"
+
 	heimdalltypes "github.com/maticnetwork/heimdall/types"
 
 	mock "github.com/stretchr/testify/mock"
@@ -432,6 +434,29 @@ func (_m *IContractCaller) GetCheckpointSign(txHash common.Hash) ([]byte, []byte
 	return r0, r1, r2, r3
 }
 
+// GetChildChainCaller provides a mock function with given fields: borChainID
+func (_m *IContractCaller) GetChildChainCaller(borChainID string) (helper.IContractCaller, error) {
+	ret := _m.Called(borChainID)
+
+	var r0 helper.IContractCaller
+	if rf, ok := ret.Get(0).(func(string) helper.IContractCaller); ok {
+		r0 = rf(borChainID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(helper.IContractCaller)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(borChainID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetConfirmedTxReceipt provides a mock function with given fields: _a0, _a1
 func (_m *IContractCaller) GetConfirmedTxReceipt(_a0 common.Hash, _a1 uint64) (*types.Receipt, error) {
 	ret := _m.Called(_a0, _a1)
@@ -598,6 +623,29 @@ func (_m *IContractCaller) GetMaticChainBlock(_a0 *big.Int) (*types.Header, erro
 	return r0, r1
 }
 
+// GetMaticChainHeaders provides a mock function with given fields: start, end
+func (_m *IContractCaller) GetMaticChainHeaders(start uint64, end uint64) ([]*types.Header, error) {
+	ret := _m.Called(start, end)
+
+	var r0 []*types.Header
+	if rf, ok := ret.Get(0).(func(uint64, uint64) []*types.Header); ok {
+		r0 = rf(start, end)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*types.Header)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(uint64, uint64) error); ok {
+		r1 = rf(start, end)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetMaticTokenInstance provides a mock function with given fields: maticTokenAddress
 func (_m *IContractCaller) GetMaticTokenInstance(maticTokenAddress common.Address) (*erc20.Erc20, error) {
 	ret := _m.Called(maticTokenAddress)
@@ -0,0 +1,285 @@
+package helper
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/maticnetwork/bor/common/hexutil"
+	"github.com/maticnetwork/bor/ethclient"
+	"github.com/maticnetwork/bor/rpc"
+)
+
+const (
+	// DefaultRPCHealthCheckInterval is the default interval between endpoint health checks
+	DefaultRPCHealthCheckInterval = 30 * time.Second
+
+	rpcHealthCheckTimeout = 5 * time.Second
+	// endpoints lagging more than rpcMaxBlockLag blocks behind the best endpoint are unhealthy
+	rpcMaxBlockLag = uint64(10)
+)
+
+// ErrRPCQuorumNotReached is returned when endpoints do not agree on a critical read
+var ErrRPCQuorumNotReached = errors.New("RPC quorum not reached")
+
+// RPCEndpoint represents a json-rpc endpoint of a pool
+type RPCEndpoint struct {
+	URL    string
+	Client *ethclient.Client
+	RPC    *rpc.Client
+
+	url         *url.URL
+	healthy     bool
+	latency     time.Duration
+	latestBlock uint64
+	lastError   error
+}
+
+// RPCEndpointStatus represents health of a json-rpc endpoint
+type RPCEndpointStatus struct {
+	URL         string        `json:"url"`
+	Healthy     bool          `json:"healthy"`
+	Latency     time.Duration `json:"latency"`
+	LatestBlock uint64        `json:"latest_block"`
+	LastError   string        `json:"last_error,omitempty"`
+}
+
+// RPCPool routes json-rpc http requests to the healthy endpoint with lowest latency
+// and fails over to next endpoint on transport errors
+type RPCPool struct {
+	name      string
+	endpoints []*RPCEndpoint
+	mutex     sync.RWMutex
+
+	transport http.RoundTripper
+}
+
+// NewRPCPool creates a pool for given http(s) endpoints
+func NewRPCPool(name string, urls []string) (*RPCPool, error) {
+	if len(urls) == 0 {
+		return nil, fmt.Errorf("No RPC endpoints for %v", name)
+	}
+
+	pool := &RPCPool{
+		name:      name,
+		transport: http.DefaultTransport,
+	}
+
+	for _, rawURL := range urls {
+		endpointURL, err := url.Parse(rawURL)
+		if err != nil {
+			return nil, err
+		}
+
+		if endpointURL.Scheme != "http" && endpointURL.Scheme != "https" {
+			return nil, fmt.Errorf("Only http(s) endpoints are supported with multiple RPC endpoints, got %v", rawURL)
+		}
+
+		rpcClient, err := rpc.DialHTTP(rawURL)
+		if err != nil {
+			return nil, err
+		}
+
+		pool.endpoints = append(pool.endpoints, &RPCEndpoint{
+			URL:     rawURL,
+			Client:  ethclient.NewClient(rpcClient),
+			RPC:     rpcClient,
+			url:     endpointURL,
+			healthy: true, // assume healthy until first health check
+		})
+	}
+
+	return pool, nil
+}
+
+// Client returns rpc client which sends requests through the pool
+func (p *RPCPool) Client() (*rpc.Client, error) {
+	return rpc.DialHTTPWithClient("http://"+p.name, &http.Client{Transport: p})
+}
+
+// Endpoints returns endpoints ordered by preference: healthy first, then by latency
+func (p *RPCPool) Endpoints() []*RPCEndpoint {
+	p.mutex.RLock()
+	defer p.mutex.RUnlock()
+
+	endpoints := make([]*RPCEndpoint, len(p.endpoints))
+	copy(endpoints, p.endpoints)
+
+	sort.SliceStable(endpoints, func(i, j int) bool {
+		if endpoints[i].healthy != endpoints[j].healthy {
+			return endpoints[i].healthy
+		}
+		return endpoints[i].latency < endpoints[j].latency
+	})
+
+	return endpoints
+}
+
+// Status returns health of all endpoints
+func (p *RPCPool) Status() []RPCEndpointStatus {
+	p.mutex.RLock()
+	defer p.mutex.RUnlock()
+
+	result := make([]RPCEndpointStatus, 0, len(p.endpoints))
+	for _, endpoint := range p.endpoints {
+		status := RPCEndpointStatus{
+			URL:         endpoint.URL,
+			Healthy:     endpoint.healthy,
+			Latency:     endpoint.latency,
+			LatestBlock: endpoint.latestBlock,
+		}
+		if endpoint.lastError != nil {
+			status.LastError = endpoint.lastError.Error()
+		}
+		result = append(result, status)
+	}
+
+	return result
+}
+
+// RoundTrip implements http.RoundTripper. Request is sent to endpoints in order of preference
+// until one of them responds without transport or server error.
+func (p *RPCPool) RoundTrip(req *http.Request) (*http.Response, error) {
+	var body []byte
+	if req.Body != nil {
+		var err error
+		if body, err = ioutil.ReadAll(req.Body); err != nil {
+			return nil, err
+		}
+		req.Body.Close()
+	}
+
+	var lastErr error
+	for _, endpoint := range p.Endpoints() {
+		endpointReq := req.WithContext(req.Context())
+		endpointURL := *endpoint.url
+		endpointReq.URL = &endpointURL
+		endpointReq.Host = ""
+		endpointReq.Body = ioutil.NopCloser(bytes.NewReader(body))
+		endpointReq.ContentLength = int64(len(body))
+
+		resp, err := p.transport.RoundTrip(endpointReq)
+		if err == nil && resp.StatusCode < http.StatusInternalServerError && resp.StatusCode != http.StatusTooManyRequests {
+			return resp, nil
+		}
+
+		if err == nil {
+			err = fmt.Errorf("%v", resp.Status)
+			resp.Body.Close()
+		}
+
+		// request is cancelled, don't try other endpoints
+		if req.Context().Err() != nil {
+			return nil, err
+		}
+
+		Logger.Error("RPC endpoint failed, trying next endpoint", "pool", p.name, "url", endpoint.URL, "error", err)
+		p.markUnhealthy(endpoint, err)
+		lastErr = err
+	}
+
+	return nil, lastErr
+}
+
+// StartHealthCheck checks health and latency of all endpoints every interval
+func (p *RPCPool) StartHealthCheck(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	p.checkHealth()
+	for {
+		select {
+		case <-ticker.C:
+			p.checkHealth()
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+func (p *RPCPool) checkHealth() {
+	type result struct {
+		latency     time.Duration
+		latestBlock uint64
+		err         error
+	}
+
+	results := make([]result, len(p.endpoints))
+
+	var wg sync.WaitGroup
+	for i, endpoint := range p.endpoints {
+		wg.Add(1)
+		go func(i int, endpoint *RPCEndpoint) {
+			defer wg.Done()
+
+			ctx, cancel := context.WithTimeout(context.Background(), rpcHealthCheckTimeout)
+			defer cancel()
+
+			var latestBlock hexutil.Uint64
+			start := time.Now()
+			err := endpoint.RPC.CallContext(ctx, &latestBlock, "eth_blockNumber")
+			results[i] = result{latency: time.Since(start), latestBlock: uint64(latestBlock), err: err}
+		}(i, endpoint)
+	}
+	wg.Wait()
+
+	// best known block among endpoints
+	bestBlock := uint64(0)
+	for _, r := range results {
+		if r.err == nil && r.latestBlock > bestBlock {
+			bestBlock = r.latestBlock
+		}
+	}
+
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	for i, endpoint := range p.endpoints {
+		r := results[i]
+		endpoint.latency = r.latency
+		endpoint.lastError = r.err
+		if r.err == nil {
+			endpoint.latestBlock = r.latestBlock
+			if r.latestBlock+rpcMaxBlockLag < bestBlock {
+				endpoint.lastError = fmt.Errorf("Endpoint is %v blocks behind", bestBlock-r.latestBlock)
+			}
+		}
+
+		healthy := endpoint.lastError == nil
+		if endpoint.healthy != healthy {
+			Logger.Info("RPC endpoint health changed", "pool", p.name, "url", endpoint.URL, "healthy", healthy, "error", endpoint.lastError)
+		}
+		endpoint.healthy = healthy
+	}
+}
+
+func (p *RPCPool) markUnhealthy(endpoint *RPCEndpoint, err error) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	endpoint.healthy = false
+	endpoint.lastError = err
+}
+
+//
+// utils
+//
+
+// splitRPCUrls splits comma separated rpc urls
+func splitRPCUrls(urls string) []string {
+	var result []string
+	for _, u := range strings.Split(urls, ",") {
+		if u = strings.TrimSpace(u); u != "" {
+			result = append(result, u)
+		}
+	}
+	return result
+}
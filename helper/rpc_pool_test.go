@@ -0,0 +1,70 @@
+package helper
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/maticnetwork/bor/common/hexutil"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestRPCServer(blockNumber uint64, status int) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if status != http.StatusOK {
+			w.WriteHeader(status)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"jsonrpc":"2.0","id":1,"result":"%v"}`, hexutil.EncodeUint64(blockNumber))
+	}))
+}
+
+func TestRPCPoolFailover(t *testing.T) {
+	failing := newTestRPCServer(0, http.StatusBadGateway)
+	defer failing.Close()
+
+	working := newTestRPCServer(100, http.StatusOK)
+	defer working.Close()
+
+	pool, err := NewRPCPool("test", []string{failing.URL, working.URL})
+	require.NoError(t, err)
+
+	client, err := pool.Client()
+	require.NoError(t, err)
+
+	var blockNumber hexutil.Uint64
+	require.NoError(t, client.Call(&blockNumber, "eth_blockNumber"))
+	require.Equal(t, uint64(100), uint64(blockNumber))
+
+	// failing endpoint is moved behind healthy one
+	endpoints := pool.Endpoints()
+	require.Equal(t, working.URL, endpoints[0].URL)
+	require.False(t, pool.Status()[0].Healthy)
+}
+
+func TestRPCPoolHealthCheck(t *testing.T) {
+	lagging := newTestRPCServer(10, http.StatusOK)
+	defer lagging.Close()
+
+	synced := newTestRPCServer(100, http.StatusOK)
+	defer synced.Close()
+
+	pool, err := NewRPCPool("test", []string{lagging.URL, synced.URL})
+	require.NoError(t, err)
+
+	pool.checkHealth()
+
+	status := pool.Status()
+	require.False(t, status[0].Healthy)
+	require.Equal(t, uint64(10), status[0].LatestBlock)
+	require.True(t, status[1].Healthy)
+	require.Equal(t, synced.URL, pool.Endpoints()[0].URL)
+}
+
+func TestNewRPCPoolInvalidScheme(t *testing.T) {
+	_, err := NewRPCPool("test", []string{"http://localhost:8545", "ws://localhost:8546"})
+	require.Error(t, err)
+}
@@ -0,0 +1,270 @@
+package helper
+
+import (
+	"bytes"
+	"context"
+	"crypto/ecdsa"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"strings"
+	"sync"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/maticnetwork/bor/accounts/abi/bind"
+	"github.com/maticnetwork/bor/accounts/keystore"
+	"github.com/maticnetwork/bor/common"
+	"github.com/maticnetwork/bor/common/hexutil"
+	"github.com/maticnetwork/bor/core/types"
+	ethCrypto "github.com/maticnetwork/bor/crypto"
+	"github.com/maticnetwork/bor/rpc"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+
+	authTypes "github.com/maticnetwork/heimdall/auth/types"
+)
+
+const (
+	// LocalSigner signs with private key from priv_validator_key.json
+	LocalSigner = "local"
+	// KeystoreSigner signs with private key from encrypted keystore file (heimdallcli generate-keystore)
+	KeystoreSigner = "keystore"
+	// RemoteSigner signs using remote json-rpc signer
+	RemoteSigner = "remote"
+
+	remoteSignerTimeout = 10 * time.Second
+)
+
+// ErrInvalidSignature is returned when signer returns signature which doesn't match its public key
+var ErrInvalidSignature = errors.New("invalid signature from signer")
+
+// Signer signs heimdall and ethereum txs with validator key
+type Signer interface {
+	// PubKey returns uncompressed public key of the signer
+	PubKey() secp256k1.PubKeySecp256k1
+
+	// SignHash signs 32 bytes hash and returns signature in [R || S || V] format
+	SignHash(hash []byte) ([]byte, error)
+}
+
+// signer used for bridge and cli txs
+var (
+	signer      Signer
+	signerMutex sync.Mutex
+)
+
+// GetSigner returns signer for configured signer type, it is created on first use and cached
+func GetSigner() (Signer, error) {
+	signerMutex.Lock()
+	defer signerMutex.Unlock()
+
+	if signer == nil {
+		s, err := NewSigner(GetConfig(), GetPrivKey())
+		if err != nil {
+			return nil, err
+		}
+		signer = s
+	}
+	return signer, nil
+}
+
+// SetSigner sets signer
+func SetSigner(s Signer) {
+	signerMutex.Lock()
+	defer signerMutex.Unlock()
+
+	signer = s
+}
+
+// NewSigner creates signer for given configuration
+func NewSigner(config Configuration, privKey secp256k1.PrivKeySecp256k1) (Signer, error) {
+	switch config.SignerType {
+	case "", LocalSigner:
+		return NewPrivKeySigner(privKey)
+	case KeystoreSigner:
+		return NewKeystoreSigner(config.SignerKeystoreFile, config.SignerPasswordFile)
+	case RemoteSigner:
+		return NewRemoteSigner(config.RemoteSignerURL)
+	default:
+		return nil, fmt.Errorf("Invalid signer type %v", config.SignerType)
+	}
+}
+
+// GetTransactor returns transact opts which sign ethereum txs with configured signer
+func GetTransactor() (*bind.TransactOpts, error) {
+	s, err := GetSigner()
+	if err != nil {
+		return nil, err
+	}
+	return NewSignerTransactor(s), nil
+}
+
+// NewSignerTransactor returns transact opts which sign ethereum txs with given signer
+func NewSignerTransactor(s Signer) *bind.TransactOpts {
+	from := SignerAddress(s)
+	return &bind.TransactOpts{
+		From: from,
+		Signer: func(txSigner types.Signer, address common.Address, tx *types.Transaction) (*types.Transaction, error) {
+			if address != from {
+				return nil, errors.New("not authorized to sign this account")
+			}
+
+			hash := txSigner.Hash(tx)
+			sig, err := s.SignHash(hash.Bytes())
+			if err != nil {
+				return nil, err
+			}
+
+			return tx.WithSignature(txSigner, sig)
+		},
+	}
+}
+
+// SignStdSignMsg signs heimdall tx with given signer
+func SignStdSignMsg(s Signer, msg authTypes.StdSignMsg) (authTypes.StdSignature, error) {
+	return s.SignHash(ethCrypto.Keccak256(msg.Bytes()))
+}
+
+// BuildAndSignWithSigner builds heimdall tx with given msgs and signs it with configured signer
+func BuildAndSignWithSigner(txBldr authTypes.TxBuilder, msgs []sdk.Msg) ([]byte, error) {
+	stdMsg, err := txBldr.BuildSignMsg(msgs)
+	if err != nil {
+		return nil, err
+	}
+
+	s, err := GetSigner()
+	if err != nil {
+		return nil, err
+	}
+
+	sig, err := SignStdSignMsg(s, stdMsg)
+	if err != nil {
+		return nil, err
+	}
+
+	return txBldr.TxEncoder()(authTypes.NewStdTx(stdMsg.Msg, sig, stdMsg.Memo))
+}
+
+// SignerAddress returns ethereum address of the signer
+func SignerAddress(s Signer) common.Address {
+	return common.BytesToAddress(s.PubKey().Address().Bytes())
+}
+
+//
+// Private key signer
+//
+
+// PrivKeySigner signs with private key held in memory
+type PrivKeySigner struct {
+	privKey *ecdsa.PrivateKey
+	pubKey  secp256k1.PubKeySecp256k1
+}
+
+// NewPrivKeySigner creates signer from secp256k1 private key
+func NewPrivKeySigner(privKey secp256k1.PrivKeySecp256k1) (*PrivKeySigner, error) {
+	ecdsaPrivKey, err := ethCrypto.ToECDSA(privKey[:])
+	if err != nil {
+		return nil, err
+	}
+
+	return newECDSASigner(ecdsaPrivKey), nil
+}
+
+// NewKeystoreSigner creates signer from encrypted geth keystore file, as generated by `heimdallcli generate-keystore`
+func NewKeystoreSigner(keystoreFile string, passwordFile string) (*PrivKeySigner, error) {
+	keyJSON, err := ioutil.ReadFile(keystoreFile)
+	if err != nil {
+		return nil, err
+	}
+
+	password, err := ioutil.ReadFile(passwordFile)
+	if err != nil {
+		return nil, err
+	}
+
+	key, err := keystore.DecryptKey(keyJSON, strings.TrimRight(string(password), "\r\n"))
+	if err != nil {
+		return nil, err
+	}
+
+	return newECDSASigner(key.PrivateKey), nil
+}
+
+func newECDSASigner(privKey *ecdsa.PrivateKey) *PrivKeySigner {
+	var pubKey secp256k1.PubKeySecp256k1
+	copy(pubKey[:], ethCrypto.FromECDSAPub(&privKey.PublicKey))
+
+	return &PrivKeySigner{
+		privKey: privKey,
+		pubKey:  pubKey,
+	}
+}
+
+// PubKey returns uncompressed public key of the signer
+func (s *PrivKeySigner) PubKey() secp256k1.PubKeySecp256k1 {
+	return s.pubKey
+}
+
+// SignHash signs 32 bytes hash
+func (s *PrivKeySigner) SignHash(hash []byte) ([]byte, error) {
+	return ethCrypto.Sign(hash, s.privKey)
+}
+
+//
+// Remote signer
+//
+
+// RemoteSignerClient signs using remote signer speaking json-rpc. signer_publicKey() returns hex encoded
+// uncompressed public key and signer_signHash(address, hash) returns hex encoded [R || S || V] signature of 32 bytes hash
+type RemoteSignerClient struct {
+	client *rpc.Client
+	pubKey secp256k1.PubKeySecp256k1
+}
+
+// NewRemoteSigner connects to remote signer and fetches its public key
+func NewRemoteSigner(url string) (*RemoteSignerClient, error) {
+	client, err := rpc.Dial(url)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), remoteSignerTimeout)
+	defer cancel()
+
+	var pubKeyBytes hexutil.Bytes
+	if err := client.CallContext(ctx, &pubKeyBytes, "signer_publicKey"); err != nil {
+		return nil, err
+	}
+
+	if _, err := ethCrypto.UnmarshalPubkey(pubKeyBytes); err != nil {
+		return nil, fmt.Errorf("Invalid public key from remote signer: %v", err)
+	}
+
+	s := &RemoteSignerClient{client: client}
+	copy(s.pubKey[:], pubKeyBytes)
+	return s, nil
+}
+
+// PubKey returns uncompressed public key of the signer
+func (s *RemoteSignerClient) PubKey() secp256k1.PubKeySecp256k1 {
+	return s.pubKey
+}
+
+// SignHash asks remote signer to sign 32 bytes hash and verifies returned signature
+func (s *RemoteSignerClient) SignHash(hash []byte) ([]byte, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), remoteSignerTimeout)
+	defer cancel()
+
+	var sig hexutil.Bytes
+	if err := s.client.CallContext(ctx, &sig, "signer_signHash", SignerAddress(s), hexutil.Bytes(hash)); err != nil {
+		return nil, err
+	}
+
+	// signature must be made by signer's key
+	pubKey, err := ethCrypto.Ecrecover(hash, sig)
+	if err != nil || !bytes.Equal(pubKey, s.pubKey[:]) {
+		return nil, ErrInvalidSignature
+	}
+
+	return sig, nil
+}
@@ -0,0 +1,166 @@
+package helper
+
+import (
+	"io/ioutil"
+	"math/big"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/maticnetwork/bor/accounts/keystore"
+	"github.com/maticnetwork/bor/common"
+	"github.com/maticnetwork/bor/common/hexutil"
+	"github.com/maticnetwork/bor/core/types"
+	ethCrypto "github.com/maticnetwork/bor/crypto"
+	"github.com/maticnetwork/bor/rpc"
+	"github.com/pborman/uuid"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+)
+
+// testRemoteSigner implements remote signer json-rpc api
+type testRemoteSigner struct {
+	signer *PrivKeySigner
+}
+
+func (s *testRemoteSigner) PublicKey() hexutil.Bytes {
+	pubKey := s.signer.PubKey()
+	return pubKey[:]
+}
+
+func (s *testRemoteSigner) SignHash(address common.Address, hash hexutil.Bytes) (hexutil.Bytes, error) {
+	return s.signer.SignHash(hash)
+}
+
+func TestPrivKeySignerTransactor(t *testing.T) {
+	privKey := secp256k1.GenPrivKey()
+	s, err := NewPrivKeySigner(privKey)
+	require.NoError(t, err)
+	require.Equal(t, privKey.PubKey().Address().Bytes(), SignerAddress(s).Bytes())
+
+	auth := NewSignerTransactor(s)
+	tx := types.NewTransaction(1, common.Address{}, big.NewInt(0), 21000, big.NewInt(1), nil)
+	signedTx, err := auth.Signer(types.HomesteadSigner{}, auth.From, tx)
+	require.NoError(t, err)
+
+	sender, err := types.Sender(types.HomesteadSigner{}, signedTx)
+	require.NoError(t, err)
+	require.Equal(t, auth.From, sender)
+}
+
+func TestKeystoreSigner(t *testing.T) {
+	dir, err := ioutil.TempDir("", "signer")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	privKey, err := ethCrypto.GenerateKey()
+	require.NoError(t, err)
+
+	// same format as heimdallcli generate-keystore
+	keyJSON, err := keystore.EncryptKey(&keystore.Key{
+		Id:         uuid.NewRandom(),
+		Address:    ethCrypto.PubkeyToAddress(privKey.PublicKey),
+		PrivateKey: privKey,
+	}, "secret", keystore.LightScryptN, keystore.LightScryptP)
+	require.NoError(t, err)
+
+	keystoreFile := filepath.Join(dir, "keystore.json")
+	passwordFile := filepath.Join(dir, "password")
+	require.NoError(t, ioutil.WriteFile(keystoreFile, keyJSON, 0600))
+	require.NoError(t, ioutil.WriteFile(passwordFile, []byte("secret\n"), 0600))
+
+	s, err := NewKeystoreSigner(keystoreFile, passwordFile)
+	require.NoError(t, err)
+	require.Equal(t, ethCrypto.PubkeyToAddress(privKey.PublicKey), SignerAddress(s))
+
+	require.NoError(t, ioutil.WriteFile(passwordFile, []byte("wrong"), 0600))
+	_, err = NewKeystoreSigner(keystoreFile, passwordFile)
+	require.Error(t, err)
+}
+
+func TestRemoteSigner(t *testing.T) {
+	localSigner, err := NewPrivKeySigner(secp256k1.GenPrivKey())
+	require.NoError(t, err)
+
+	server := rpc.NewServer()
+	require.NoError(t, server.RegisterName("signer", &testRemoteSigner{signer: localSigner}))
+	httpServer := httptest.NewServer(server)
+	defer httpServer.Close()
+
+	s, err := NewRemoteSigner(httpServer.URL)
+	require.NoError(t, err)
+	require.Equal(t, localSigner.PubKey(), s.PubKey())
+
+	hash := ethCrypto.Keccak256([]byte("heimdall"))
+	sig, err := s.SignHash(hash)
+	require.NoError(t, err)
+
+	pubKey, err := ethCrypto.Ecrecover(hash, sig)
+	require.NoError(t, err)
+	require.Equal(t, localSigner.pubKey[:], pubKey)
+
+	// signature by another key is rejected
+	otherSigner, err := NewPrivKeySigner(secp256k1.GenPrivKey())
+	require.NoError(t, err)
+	s.pubKey = otherSigner.PubKey()
+	_, err = s.SignHash(hash)
+	require.Equal(t, ErrInvalidSignature, err)
+}
+
+func TestGetSigner(t *testing.T) {
+	defer SetSigner(nil)
+
+	// invalid (empty) private key is reported instead of returning nil signer
+	SetSigner(nil)
+	_, err := GetSigner()
+	require.Error(t, err)
+
+	_, err = GetTransactor()
+	require.Error(t, err)
+
+	// configured signer is returned as is
+	s, err := NewPrivKeySigner(secp256k1.GenPrivKey())
+	require.NoError(t, err)
+	SetSigner(s)
+
+	got, err := GetSigner()
+	require.NoError(t, err)
+	require.Equal(t, s, got)
+}
+
+func TestGetSignerFromConfig(t *testing.T) {
+	localSigner, err := NewPrivKeySigner(secp256k1.GenPrivKey())
+	require.NoError(t, err)
+
+	server := rpc.NewServer()
+	require.NoError(t, server.RegisterName("signer", &testRemoteSigner{signer: localSigner}))
+	httpServer := httptest.NewServer(server)
+	defer httpServer.Close()
+
+	prevConf, prevPubObject := conf, pubObject
+	defer func() {
+		conf, pubObject = prevConf, prevPubObject
+		SetSigner(nil)
+	}()
+
+	// remote signer without validator key file, signer is created on first use
+	SetTestConfig(Configuration{SignerType: RemoteSigner, RemoteSignerURL: httpServer.URL})
+	pubObject = secp256k1.PubKeySecp256k1{}
+	SetSigner(nil)
+
+	s, err := GetSigner()
+	require.NoError(t, err)
+	require.Equal(t, localSigner.PubKey(), s.PubKey())
+	require.Equal(t, localSigner.PubKey(), GetPubKey())
+
+	// validator key is kept for local signer
+	privKey := secp256k1.GenPrivKey()
+	SetTestConfig(Configuration{SignerType: LocalSigner})
+	SetTestPrivKey(privKey)
+	require.Equal(t, privKey.PubKey(), GetPubKey())
+
+	s, err = GetSigner()
+	require.NoError(t, err)
+	require.Equal(t, privKey.PubKey(), s.PubKey())
+}
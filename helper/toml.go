@@ -15,12 +15,21 @@ const defaultConfigTemplate = `# This is a TOML config file.
 
 ##### RPC and REST configs #####
 
-# RPC endpoint for ethereum chain
+# RPC endpoint for ethereum chain (comma separated http(s) endpoints for failover)
 eth_rpc_url = "{{ .EthRPCUrl }}"
 
-# RPC endpoint for bor chain
+# RPC endpoint for bor chain (comma separated http(s) endpoints for failover)
 bor_rpc_url = "{{ .BorRPCUrl }}"
 
+# RPC endpoints of secondary child chains (<bor-chain-id>=<comma separated urls>, separated by ;)
+child_chain_rpc_urls = "{{ .ChildChainRPCUrls }}"
+
+# Health check interval for multiple RPC endpoints
+rpc_health_check_interval = "{{ .RPCHealthCheckInterval }}"
+
+# Number of ethereum endpoints which must return same receipt for critical reads (0 = disabled)
+main_chain_rpc_quorum = "{{ .MainchainRPCQuorum }}"
+
 # RPC endpoint for tendermint
 tendermint_rpc_url = "{{ .TendermintRPCUrl }}"
 
@@ -32,6 +41,12 @@ heimdall_rest_server = "{{ .HeimdallServerURL }}"
 # AMQP endpoint
 amqp_url = "{{ .AmqpURL }}"
 
+# Queue backend for bridge tasks: "amqp" (RabbitMQ), "leveldb" (stored in bridge db) or "memory" (in-process, for tests)
+queue_backend = "{{ .QueueBackend }}"
+
+# Prometheus metrics listen address for bridge (empty to disable)
+bridge_metrics_listen_addr = "{{ .BridgeMetricsListenAddr }}"
+
 ## Poll intervals
 checkpoint_poll_interval = "{{ .CheckpointerPollInterval }}"
 syncer_poll_interval = "{{ .SyncerPollInterval }}"
@@ -42,9 +57,39 @@ span_poll_interval = "{{ .SpanPollInterval }}"
 #### gas limits ####
 main_chain_gas_limit = "{{ .MainchainGasLimit }}"
 
+#### gas price ####
+main_chain_max_gas_price = "{{ .MainchainMaxGasPrice }}"
+
+#### mainchain log filtering ####
+# max blocks per log filter request (smaller ranges are retried if provider returns too many results)
+main_chain_logs_window = "{{ .MainchainLogsWindow }}"
+# max log filter requests per second (0 = unlimited)
+main_chain_logs_rate_limit = "{{ .MainchainLogsRateLimit }}"
+
 ##### Timeout Config #####
 no_ack_wait_time = "{{ .NoACKWaitTime }}"
 
+#### signer for bridge and cli txs ####
+# "local" (priv_validator_key.json), "keystore" or "remote"
+signer_type = "{{ .SignerType }}"
+# keystore signer: file generated by "heimdallcli generate-keystore" and file with its password
+signer_keystore_file = "{{ .SignerKeystoreFile }}"
+signer_password_file = "{{ .SignerPasswordFile }}"
+# remote signer: json-rpc url (signer_publicKey, signer_signHash)
+remote_signer_url = "{{ .RemoteSignerURL }}"
+
+#### bridge notifications ####
+# comma separated webhooks as <format>:<min severity>:<url>
+# format is "json" or "slack", severity is "info", "warning" or "critical"
+# eg. "slack:critical:https://hooks.slack.com/services/XXX,json:info:http://localhost:8080/events"
+notify_webhooks = "{{ .NotifyWebhooks }}"
+# min interval between notifications of same type to a webhook (0 = unlimited)
+notify_rate_limit = "{{ .NotifyRateLimit }}"
+# interval to check fee token balance on heimdall and ETH balance on main chain (0 = disabled)
+balance_check_interval = "{{ .BalanceCheckInterval }}"
+# fee token balance below which bridge notifies
+min_fee_balance = "{{ .MinFeeBalance }}"
+
 `
 
 var configTemplate *template.Template
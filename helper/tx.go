@@ -8,7 +8,6 @@ import (
 	ethereum "github.com/maticnetwork/bor"
 	"github.com/maticnetwork/bor/accounts/abi/bind"
 	"github.com/maticnetwork/bor/common"
-	"github.com/maticnetwork/bor/crypto"
 	"github.com/maticnetwork/bor/ethclient"
 	"github.com/maticnetwork/heimdall/contracts/erc20"
 	"github.com/maticnetwork/heimdall/contracts/rootchain"
@@ -23,17 +22,14 @@ func GenerateAuthObj(client *ethclient.Client, address common.Address, data []by
 		Data: data,
 	}
 
-	// get priv key
-	pkObject := GetPrivKey()
-
-	// create ecdsa private key
-	ecdsaPrivateKey, err := crypto.ToECDSA(pkObject[:])
+	// create auth
+	auth, err = GetTransactor()
 	if err != nil {
 		return
 	}
 
 	// from address
-	fromAddress := common.BytesToAddress(pkObject.PubKey().Address().Bytes())
+	fromAddress := auth.From
 	// fetch gas price
 	gasprice, err := client.SuggestGasPrice(context.Background())
 	if err != nil {
@@ -49,8 +45,6 @@ func GenerateAuthObj(client *ethclient.Client, address common.Address, data []by
 	callMsg.From = fromAddress
 	gasLimit, err := client.EstimateGas(context.Background(), callMsg)
 
-	// create auth
-	auth = bind.NewKeyedTransactor(ecdsaPrivateKey)
 	auth.GasPrice = gasprice
 	auth.Nonce = big.NewInt(int64(nonce))
 	auth.GasLimit = uint64(gasLimit) // uint64(gasLimit)
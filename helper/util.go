@@ -180,17 +180,8 @@ type sideTxSig struct {
 
 // GetSideTxSigs returns sigs bytes from vote by tx hash
 func GetSideTxSigs(txHash []byte, sideTxData []byte, unFilteredVotes []*tmTypes.CommitSig) (sigs []byte) {
-	// side tx result with data
-	sideTxResultWithData := tmTypes.SideTxResultWithData{
-		SideTxResult: tmTypes.SideTxResult{
-			TxHash: txHash,
-			Result: int32(abci.SideTxResultType_Yes),
-		},
-		Data: sideTxData,
-	}
-
 	// draft signed data
-	signedData := sideTxResultWithData.GetBytes()
+	signedData := getSideTxSignedData(txHash, sideTxData)
 
 	sideTxSigs := make([]*sideTxSig, 0)
 	for _, vote := range unFilteredVotes {
@@ -199,27 +190,78 @@ func GetSideTxSigs(txHash []byte, sideTxData []byte, unFilteredVotes []*tmTypes.
 			for _, sideTxResult := range vote.SideTxResults {
 				// find side-tx result by tx-hash
 				if bytes.Equal(sideTxResult.TxHash, txHash) &&
-					len(sideTxResult.Sig) == 65 &&
-					sideTxResult.Result == int32(abci.SideTxResultType_Yes) {
-					// validate sig
-					var pk secp256k1.PubKeySecp256k1
-					if p, err := authTypes.RecoverPubkey(signedData, sideTxResult.Sig); err == nil {
-						copy(pk[:], p[:])
-
-						// if it has valid sig, add it into side-tx sig array
-						if bytes.Equal(vote.ValidatorAddress.Bytes(), pk.Address().Bytes()) {
-							sideTxSigs = append(sideTxSigs, &sideTxSig{
-								Address: vote.ValidatorAddress.Bytes(),
-								Sig:     sideTxResult.Sig,
-							})
-						}
-					}
+					sideTxResult.Result == int32(abci.SideTxResultType_Yes) &&
+					isValidSideTxSig(signedData, vote.ValidatorAddress.Bytes(), sideTxResult.Sig) {
+					// if it has valid sig, add it into side-tx sig array
+					sideTxSigs = append(sideTxSigs, &sideTxSig{
+						Address: vote.ValidatorAddress.Bytes(),
+						Sig:     sideTxResult.Sig,
+					})
 				}
 				// break
 			}
 		}
 	}
 
+	return joinSideTxSigs(sideTxSigs)
+}
+
+// GetSideTxSigsFromResult returns sigs bytes from yes votes of side-tx result
+func GetSideTxSigsFromResult(txHash []byte, sideTxData []byte, resultSigs []abci.SideTxSig) (sigs []byte) {
+	// draft signed data
+	signedData := getSideTxSignedData(txHash, sideTxData)
+
+	usedAddress := make(map[string]bool)
+	sideTxSigs := make([]*sideTxSig, 0)
+	for _, sigObj := range resultSigs {
+		if sigObj.Result == abci.SideTxResultType_Yes &&
+			!usedAddress[string(sigObj.Address)] &&
+			isValidSideTxSig(signedData, sigObj.Address, sigObj.Sig) {
+			usedAddress[string(sigObj.Address)] = true
+			sideTxSigs = append(sideTxSigs, &sideTxSig{
+				Address: sigObj.Address,
+				Sig:     sigObj.Sig,
+			})
+		}
+	}
+
+	return joinSideTxSigs(sideTxSigs)
+}
+
+// getSideTxSignedData returns data signed by validators for side-tx
+func getSideTxSignedData(txHash []byte, sideTxData []byte) []byte {
+	// side tx result with data
+	sideTxResultWithData := tmTypes.SideTxResultWithData{
+		SideTxResult: tmTypes.SideTxResult{
+			TxHash: txHash,
+			Result: int32(abci.SideTxResultType_Yes),
+		},
+		Data: sideTxData,
+	}
+
+	return sideTxResultWithData.GetBytes()
+}
+
+// isValidSideTxSig checks if sig on signed data is created by given validator address
+func isValidSideTxSig(signedData []byte, address []byte, sig []byte) bool {
+	if len(sig) != 65 {
+		return false
+	}
+
+	// validate sig
+	p, err := authTypes.RecoverPubkey(signedData, sig)
+	if err != nil {
+		return false
+	}
+
+	var pk secp256k1.PubKeySecp256k1
+	copy(pk[:], p[:])
+
+	return bytes.Equal(address, pk.Address().Bytes())
+}
+
+// joinSideTxSigs sorts side-tx sigs by validator address and returns concatenated sigs
+func joinSideTxSigs(sideTxSigs []*sideTxSig) (sigs []byte) {
 	if len(sideTxSigs) > 0 {
 		// sort sigs by address
 		sort.Slice(sideTxSigs, func(i, j int) bool {
@@ -348,7 +390,7 @@ func GetSignedTxBytes(cliCtx context.CLIContext, txBldr authTypes.TxBuilder, msg
 
 	fromName := cliCtx.GetFromName()
 	if fromName == "" {
-		return txBldr.BuildAndSign(GetPrivKey(), msgs)
+		return BuildAndSignWithSigner(txBldr, msgs)
 	}
 
 	if cliCtx.Simulate {
@@ -398,7 +440,7 @@ func GetSignedTxBytesWithCLI(cliCtx context.CLIContext, txBldr authTypes.TxBuild
 
 	fromName := cliCtx.GetFromName()
 	if fromName == "" {
-		return txBldr.BuildAndSign(GetPrivKey(), msgs)
+		return BuildAndSignWithSigner(txBldr, msgs)
 	}
 
 	if cliCtx.Simulate {
@@ -531,7 +573,27 @@ func SignStdTx(
 		return txBldr.SignStdTxWithPassphrase(fromName, passphrase, stdTx, appendSig)
 	}
 
-	return txBldr.SignStdTx(GetPrivKey(), stdTx, appendSig)
+	if txBldr.ChainID() == "" {
+		return signedStdTx, fmt.Errorf("chain ID required but not specified")
+	}
+
+	s, err := GetSigner()
+	if err != nil {
+		return signedStdTx, err
+	}
+
+	sig, err := SignStdSignMsg(s, authTypes.StdSignMsg{
+		ChainID:       txBldr.ChainID(),
+		AccountNumber: txBldr.AccountNumber(),
+		Sequence:      txBldr.Sequence(),
+		Memo:          stdTx.Memo,
+		Msg:           stdTx.Msg, // allow only one message
+	})
+	if err != nil {
+		return signedStdTx, err
+	}
+
+	return authTypes.NewStdTx(stdTx.Msg, sig, stdTx.Memo), nil
 }
 
 // ReadStdTxFromFile and decode a StdTx from the given filename.  Can pass "-" to read from stdin.
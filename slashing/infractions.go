@@ -79,7 +79,7 @@ func (k *Keeper) HandleValidatorSignature(ctx sdk.Context, addr []byte, power in
 			k.Logger(ctx).Info(fmt.Sprintf("Validator %s past min height of %d and below signed blocks threshold of %d",
 				validator.ID, minHeight, k.MinSignedPerWindow(ctx)))
 
-			slashedAmount := k.SlashInterim(ctx, validator.ID, params.SlashFractionDowntime)
+			slashedAmount := k.SlashInterim(ctx, validator.ID, params.SlashFractionDowntime, hmTypes.InfractionDowntime)
 			k.Logger(ctx).Debug("Interim uptime slashing successful", "valID", validator.ID, "slashedAmount", slashedAmount)
 
 			// We need to reset the counter & array so that the validator won't be immediately slashed for downtime upon rebonding.
@@ -143,7 +143,7 @@ func (k *Keeper) HandleDoubleSign(ctx sdk.Context, evidence types.Equivocation)
 		// Validator was (a) not found or (b) already jailed, don't slash
 		k.Logger(ctx).Info(fmt.Sprintf("Validator %s would have been slashed for double time, but was either not found in store or already jailed", validator.ID))
 	} else {
-		slashedAmount := k.SlashInterim(ctx, validator.ID, params.SlashFractionDoubleSign)
+		slashedAmount := k.SlashInterim(ctx, validator.ID, params.SlashFractionDoubleSign, hmTypes.InfractionDoubleSign)
 		k.Logger(ctx).Debug("Interim uptime slashing successful", "valID", validator.ID, "slashedAmount", slashedAmount)
 	}
 
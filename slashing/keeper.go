@@ -228,7 +228,7 @@ func (k Keeper) IncrementTickCount(ctx sdk.Context) {
 // Slashing Info api's
 
 // SlashInterim - Add slash amounts to a buffer and emit <slash-limit> event if exceeded
-func (k *Keeper) SlashInterim(ctx sdk.Context, valID hmTypes.ValidatorID, slashPercent sdk.Dec) uint64 {
+func (k *Keeper) SlashInterim(ctx sdk.Context, valID hmTypes.ValidatorID, slashPercent sdk.Dec, infraction string) uint64 {
 	if slashPercent.IsNegative() {
 		panic(fmt.Errorf("attempted to slash with a negative slash factor: %v", slashPercent))
 	}
@@ -243,7 +243,7 @@ func (k *Keeper) SlashInterim(ctx sdk.Context, valID hmTypes.ValidatorID, slashP
 	slashAmountDec := sdk.NewDec(valPower).Mul(slashPercent)
 	slashAmountInt := slashAmountDec.TruncateInt().Int64()
 
-	k.Logger(ctx).Info("Interim slashing the validator", "valID", valID, "valPower", valPower, "slashPercent", slashPercent, "slashAmountDec", slashAmountDec, "slashAmountInt", slashAmountInt)
+	k.Logger(ctx).Info("Interim slashing the validator", "valID", valID, "infraction", infraction, "valPower", valPower, "slashPercent", slashPercent, "slashAmountDec", slashAmountDec, "slashAmountInt", slashAmountInt)
 
 	// Add slash to buffer
 	valSlashingInfo, found := k.GetBufferValSlashingInfo(ctx, valID)
@@ -256,6 +256,7 @@ func (k *Keeper) SlashInterim(ctx sdk.Context, valID hmTypes.ValidatorID, slashP
 		// create slashing info
 		valSlashingInfo = hmTypes.NewValidatorSlashingInfo(valID, uint64(slashAmountInt), false)
 	}
+	valSlashingInfo.AddInfraction(infraction)
 
 	// Check if jailLimit is exceeded and update the jail status.
 	if k.IsJailLimitExceeded(ctx, valSlashingInfo) {
@@ -10,3 +10,16 @@ type SideTxHandler func(ctx sdk.Context, msg sdk.Msg) abci.ResponseDeliverSideTx
 
 // PostTxHandler defines the core of the state transition function of an application after side-tx execution
 type PostTxHandler func(ctx sdk.Context, msg sdk.Msg, sideTxResult abci.SideTxResultType) sdk.Result
+
+type sideTxSigsKey struct{}
+
+// WithSideTxSigs returns context carrying validator sigs of approved side-tx
+func WithSideTxSigs(ctx sdk.Context, sigs []abci.SideTxSig) sdk.Context {
+	return ctx.WithValue(sideTxSigsKey{}, sigs)
+}
+
+// GetSideTxSigs returns validator sigs of approved side-tx from context
+func GetSideTxSigs(ctx sdk.Context) []abci.SideTxSig {
+	sigs, _ := ctx.Value(sideTxSigsKey{}).([]abci.SideTxSig)
+	return sigs
+}
@@ -14,6 +14,7 @@ type Span struct {
 	ValidatorSet      ValidatorSet `json:"validator_set" yaml:"validator_set"`
 	SelectedProducers []Validator  `json:"selected_producers" yaml:"selected_producers"`
 	ChainID           string       `json:"bor_chain_id" yaml:"bor_chain_id"`
+	ProducerSelection string       `json:"producer_selection,omitempty" yaml:"producer_selection,omitempty"` // strategy which selected producers, empty for spans before strategies were recorded
 }
 
 // NewSpan creates new span
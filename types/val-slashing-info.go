@@ -7,11 +7,19 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec"
 )
 
+// Infraction types recorded in validator slashing info
+const (
+	InfractionDowntime        = "downtime"
+	InfractionDoubleSign      = "double-sign"
+	InfractionCheckpointNoAck = "checkpoint-no-ack"
+)
+
 // ValidatorSlashingInfo - contains ID, slashingAmount, isJailed
 type ValidatorSlashingInfo struct {
 	ID            ValidatorID `json:"ID"`
 	SlashedAmount uint64      `json:"SlashedAmount"`
 	IsJailed      bool        `json:"IsJailed"`
+	Infractions   []string    `json:"Infractions,omitempty"` // infraction types slashed for, not part of rlp encoded slashing info
 }
 
 func NewValidatorSlashingInfo(id ValidatorID, slashedAmount uint64, isJailed bool) ValidatorSlashingInfo {
@@ -27,8 +35,19 @@ func (v ValidatorSlashingInfo) String() string {
 	return fmt.Sprintf(`Validator Slashing Info:
 	ID:               %d
 	SlashedAmount:    %d
-	IsJailed:         %v`,
-		v.ID, v.SlashedAmount, v.IsJailed)
+	IsJailed:         %v
+	Infractions:      %v`,
+		v.ID, v.SlashedAmount, v.IsJailed, v.Infractions)
+}
+
+// AddInfraction records infraction type, each type is recorded once
+func (v *ValidatorSlashingInfo) AddInfraction(infraction string) {
+	for _, i := range v.Infractions {
+		if i == infraction {
+			return
+		}
+	}
+	v.Infractions = append(v.Infractions, infraction)
 }
 
 // SortValidatorSlashingInfoByID - Sorts ValidatorSlashingInfo By ID